@@ -0,0 +1,577 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package btree implements support for a generic sorted BTree.
+// A BTree is an OrderedCollection which keeps its elements sorted in a B-tree:
+// every node holds up to 2*degree-1 elements in a contiguous slice, so that the
+// tree stays shallow and memory overhead is a small fraction of a pointer per element,
+// compared to the two or three pointers per element of a binary search tree.
+//
+// Insertions, removals, lookups and access by index run in O(log n) time, which keeps
+// a BTree fast for very large datasets where inserting into a sorted slice, at O(n) per
+// insertion, becomes prohibitive. Elements are ordered by cmp.Compare, or by a comparison
+// function, and equal elements are kept in insertion order.
+package btree
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math/rand"
+	"slices"
+
+	"github.com/charbz/gophers/collection"
+	"github.com/charbz/gophers/sequence"
+)
+
+// degree is the minimum degree of the tree: nodes other than the root hold
+// between degree-1 and 2*degree-1 elements.
+const degree = 32
+
+type node[T any] struct {
+	items    []T
+	children []*node[T]
+	size     int // number of elements in the subtree
+}
+
+func (n *node[T]) leaf() bool {
+	return len(n.children) == 0
+}
+
+// BTree is an ordered collection whose elements are kept sorted.
+type BTree[T any] struct {
+	root    *node[T]
+	compare func(a, b T) int
+}
+
+// New is a constructor for a BTree holding the elements of the given slices,
+// ordered by cmp.Compare.
+//
+// example usage:
+//
+//	New([]int{3,1,2}, []int{1})
+//
+// output:
+//
+//	BTree(int) [1 1 2 3]
+func New[T cmp.Ordered](s ...[]T) *BTree[T] {
+	return NewFunc(cmp.Compare[T], s...)
+}
+
+// NewFunc is a constructor for a BTree holding the elements of the given slices,
+// ordered by the comparison function, which returns a negative number when a < b,
+// a positive number when a > b and zero when a and b are equal.
+//
+// example usage:
+//
+//	byAge := func(a, b User) int { return cmp.Compare(a.Age, b.Age) }
+//	NewFunc(byAge, users)
+func NewFunc[T any](compare func(a, b T) int, s ...[]T) *BTree[T] {
+	t := &BTree[T]{compare: compare}
+	for _, slice := range s {
+		t.AddAll(slice...)
+	}
+	return t
+}
+
+// The following methods implement
+// the Collection interface.
+
+// Add inserts a value into the tree, after the elements equal to it.
+func (t *BTree[T]) Add(v T) {
+	if t.root == nil {
+		t.root = &node[T]{items: []T{v}, size: 1}
+		return
+	}
+	if len(t.root.items) == 2*degree-1 {
+		root := &node[T]{children: []*node[T]{t.root}, size: t.root.size}
+		t.split(root, 0)
+		t.root = root
+	}
+	t.insert(t.root, v)
+}
+
+// Length returns the number of elements in the tree.
+func (t *BTree[T]) Length() int {
+	if t.root == nil {
+		return 0
+	}
+	return t.root.size
+}
+
+// New returns a new tree with the same ordering holding the given elements.
+func (t *BTree[T]) New(s ...[]T) collection.Collection[T] {
+	return NewFunc(t.compare, s...)
+}
+
+// Random returns a random element of the tree.
+// It panics with an EmptyCollectionError if the tree is empty,
+// use Choice to get an error instead.
+func (t *BTree[T]) Random() T {
+	if t.Length() == 0 {
+		panic(collection.EmptyCollectionError)
+	}
+	return t.At(rand.Intn(t.Length()))
+}
+
+// Choice is an alias for collection.Choice
+func (t *BTree[T]) Choice() (T, error) {
+	return collection.Choice(t)
+}
+
+// Values returns an iterator over the elements of the tree in ascending order.
+func (t *BTree[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if t.root != nil {
+			ascend(t.root, 0, yield)
+		}
+	}
+}
+
+// The following methods implement
+// the OrderedCollection interface.
+
+// At returns the element at the given index in ascending order.
+// It panics with an IndexOutOfBoundsError if the index is out of range.
+func (t *BTree[T]) At(index int) T {
+	if index < 0 || index >= t.Length() {
+		panic(collection.IndexOutOfBoundsError)
+	}
+	n := t.root
+	for !n.leaf() {
+		j := 0
+		for ; index >= n.children[j].size; j++ {
+			index -= n.children[j].size
+			if index == 0 {
+				return n.items[j]
+			}
+			index--
+		}
+		n = n.children[j]
+	}
+	return n.items[index]
+}
+
+// All returns an iterator over the index/value pairs of the tree in ascending order.
+func (t *BTree[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for v := range t.Values() {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Backward returns an iterator over the index/value pairs of the tree in descending order.
+func (t *BTree[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		if t.root == nil {
+			return
+		}
+		i := t.root.size - 1
+		descend(t.root, func(v T) bool {
+			ok := yield(i, v)
+			i--
+			return ok
+		})
+	}
+}
+
+// Slice returns a new tree holding the elements from the start index to the end index,
+// which are already in order. It panics with an IndexOutOfBoundsError if the indices are out of range.
+func (t *BTree[T]) Slice(start, end int) collection.OrderedCollection[T] {
+	if start < 0 || end > t.Length() || start > end {
+		panic(collection.IndexOutOfBoundsError)
+	}
+	r := NewFunc(t.compare)
+	if start == end {
+		return r
+	}
+	n := end - start
+	ascend(t.root, start, func(v T) bool {
+		r.Add(v)
+		n--
+		return n > 0
+	})
+	return r
+}
+
+// NewOrdered returns a new Sequence holding the given elements in insertion order,
+// so that positional functions such as Reverse or Interleave building their result
+// from it keep the order they produce instead of having it sorted again.
+func (t *BTree[T]) NewOrdered(s ...[]T) collection.OrderedCollection[T] {
+	return sequence.NewSequence(s...)
+}
+
+// The following methods implement
+// the MutableCollection interface.
+
+// AddAll inserts all the given values into the tree.
+func (t *BTree[T]) AddAll(v ...T) {
+	for _, e := range v {
+		t.Add(e)
+	}
+}
+
+// Clear removes all elements from the tree.
+func (t *BTree[T]) Clear() {
+	t.root = nil
+}
+
+// Remove removes an element equal to v from the tree
+// and returns true if an element was removed.
+func (t *BTree[T]) Remove(v T) bool {
+	if !t.Contains(v) {
+		return false
+	}
+	t.remove(t.root, v)
+	if len(t.root.items) == 0 {
+		if t.root.leaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+	return true
+}
+
+// RemoveWhere removes all the elements that satisfy the predicate
+// and returns the number of elements removed.
+func (t *BTree[T]) RemoveWhere(f func(T) bool) int {
+	kept := make([]T, 0, t.Length())
+	for v := range t.Values() {
+		if !f(v) {
+			kept = append(kept, v)
+		}
+	}
+	removed := t.Length() - len(kept)
+	if removed > 0 {
+		t.Clear()
+		t.AddAll(kept...)
+	}
+	return removed
+}
+
+// The following methods are specific to the BTree type.
+
+// Contains returns true if the tree contains an element equal to v.
+func (t *BTree[T]) Contains(v T) bool {
+	for n := t.root; n != nil; {
+		i := t.lowerBound(n.items, v)
+		if i < len(n.items) && t.compare(n.items[i], v) == 0 {
+			return true
+		}
+		if n.leaf() {
+			return false
+		}
+		n = n.children[i]
+	}
+	return false
+}
+
+// IsEmpty returns true if the tree has no elements.
+func (t *BTree[T]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Max returns the greatest element of the tree,
+// or an EmptyCollectionError if the tree is empty.
+func (t *BTree[T]) Max() (T, error) {
+	if t.root == nil {
+		return *new(T), collection.EmptyCollectionError
+	}
+	return t.At(t.root.size - 1), nil
+}
+
+// Min returns the smallest element of the tree,
+// or an EmptyCollectionError if the tree is empty.
+func (t *BTree[T]) Min() (T, error) {
+	if t.root == nil {
+		return *new(T), collection.EmptyCollectionError
+	}
+	return t.At(0), nil
+}
+
+// Range returns an iterator over the elements in the range [from, to), in ascending order.
+// It seeks the first element in O(log n) time and stops at the end of the range.
+//
+// example usage:
+//
+//	t := New([]int{1,2,3,4,5})
+//	slices.Collect(t.Range(2, 4))
+//
+// output:
+//
+//	[2 3]
+func (t *BTree[T]) Range(from, to T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if t.root == nil {
+			return
+		}
+		ascend(t.root, t.Rank(from), func(v T) bool {
+			return t.compare(v, to) < 0 && yield(v)
+		})
+	}
+}
+
+// Rank returns the number of elements strictly less than v,
+// which is the index of the first element equal to v if there is one.
+//
+// example usage:
+//
+//	New([]int{10,20,20,30}).Rank(20)
+//
+// output:
+//
+//	1
+func (t *BTree[T]) Rank(v T) int {
+	rank := 0
+	for n := t.root; n != nil; {
+		i := t.lowerBound(n.items, v)
+		rank += i
+		if n.leaf() {
+			break
+		}
+		for _, c := range n.children[:i] {
+			rank += c.size
+		}
+		n = n.children[i]
+	}
+	return rank
+}
+
+// ToSlice returns a slice holding the elements of the tree in ascending order.
+func (t *BTree[T]) ToSlice() []T {
+	return collection.AppendToSlice(t, make([]T, 0, t.Length()))
+}
+
+// implement the Stringer interface
+func (t *BTree[T]) String() string {
+	return fmt.Sprintf("BTree(%T) %v", *new(T), t.ToSlice())
+}
+
+// lowerBound returns the index of the first item not less than v.
+func (t *BTree[T]) lowerBound(items []T, v T) int {
+	i, _ := slices.BinarySearchFunc(items, v, t.compare)
+	return i
+}
+
+// upperBound returns the index of the first item greater than v.
+func (t *BTree[T]) upperBound(items []T, v T) int {
+	i, _ := slices.BinarySearchFunc(items, v, func(e, v T) int {
+		if t.compare(e, v) <= 0 {
+			return -1
+		}
+		return 1
+	})
+	return i
+}
+
+// insert inserts v into the subtree of a node which is not full.
+func (t *BTree[T]) insert(n *node[T], v T) {
+	for {
+		n.size++
+		i := t.upperBound(n.items, v)
+		if n.leaf() {
+			n.items = slices.Insert(n.items, i, v)
+			return
+		}
+		if len(n.children[i].items) == 2*degree-1 {
+			t.split(n, i)
+			if t.compare(v, n.items[i]) >= 0 {
+				i++
+			}
+		}
+		n = n.children[i]
+	}
+}
+
+// split splits the full child i of a node in two around its median item,
+// which moves up into the node.
+func (t *BTree[T]) split(n *node[T], i int) {
+	c := n.children[i]
+	right := &node[T]{items: slices.Clone(c.items[degree:])}
+	median := c.items[degree-1]
+	clear(c.items[degree-1:])
+	c.items = c.items[:degree-1]
+	if !c.leaf() {
+		right.children = slices.Clone(c.children[degree:])
+		clear(c.children[degree:])
+		c.children = c.children[:degree]
+	}
+	right.size = subtreeSize(right)
+	c.size -= right.size + 1
+	n.items = slices.Insert(n.items, i, median)
+	n.children = slices.Insert(n.children, i+1, right)
+}
+
+// remove removes an item equal to v from the subtree of a node, which must contain one.
+// Every node it descends into has at least degree items, so that removing from it
+// never leaves it with fewer than degree-1 items.
+func (t *BTree[T]) remove(n *node[T], v T) {
+	for {
+		n.size--
+		i := t.lowerBound(n.items, v)
+		found := i < len(n.items) && t.compare(n.items[i], v) == 0
+		switch {
+		case found && n.leaf():
+			n.items = slices.Delete(n.items, i, i+1)
+			return
+		case found && len(n.children[i].items) >= degree:
+			n.items[i] = removeMax(n.children[i])
+			return
+		case found && len(n.children[i+1].items) >= degree:
+			n.items[i] = removeMin(n.children[i+1])
+			return
+		case found:
+			merge(n, i)
+		default:
+			i = grow(n, i)
+		}
+		n = n.children[i]
+	}
+}
+
+// removeMax removes and returns the greatest item of the subtree of a node
+// which has at least degree items.
+func removeMax[T any](n *node[T]) T {
+	for {
+		n.size--
+		if n.leaf() {
+			v := n.items[len(n.items)-1]
+			n.items = slices.Delete(n.items, len(n.items)-1, len(n.items))
+			return v
+		}
+		n = n.children[grow(n, len(n.children)-1)]
+	}
+}
+
+// removeMin removes and returns the smallest item of the subtree of a node
+// which has at least degree items.
+func removeMin[T any](n *node[T]) T {
+	for {
+		n.size--
+		if n.leaf() {
+			v := n.items[0]
+			n.items = slices.Delete(n.items, 0, 1)
+			return v
+		}
+		n = n.children[grow(n, 0)]
+	}
+}
+
+// grow ensures the child i of a node has at least degree items, by borrowing an item
+// from a sibling or by merging it with a sibling, and returns the new index of the child.
+func grow[T any](n *node[T], i int) int {
+	c := n.children[i]
+	if len(c.items) >= degree {
+		return i
+	}
+	if i > 0 && len(n.children[i-1].items) >= degree {
+		left := n.children[i-1]
+		moved := 1
+		c.items = slices.Insert(c.items, 0, n.items[i-1])
+		n.items[i-1] = left.items[len(left.items)-1]
+		left.items = slices.Delete(left.items, len(left.items)-1, len(left.items))
+		if !left.leaf() {
+			last := left.children[len(left.children)-1]
+			left.children = slices.Delete(left.children, len(left.children)-1, len(left.children))
+			c.children = slices.Insert(c.children, 0, last)
+			moved += last.size
+		}
+		left.size -= moved
+		c.size += moved
+		return i
+	}
+	if i < len(n.children)-1 && len(n.children[i+1].items) >= degree {
+		right := n.children[i+1]
+		moved := 1
+		c.items = append(c.items, n.items[i])
+		n.items[i] = right.items[0]
+		right.items = slices.Delete(right.items, 0, 1)
+		if !right.leaf() {
+			first := right.children[0]
+			right.children = slices.Delete(right.children, 0, 1)
+			c.children = append(c.children, first)
+			moved += first.size
+		}
+		right.size -= moved
+		c.size += moved
+		return i
+	}
+	if i == len(n.children)-1 {
+		i--
+	}
+	merge(n, i)
+	return i
+}
+
+// merge merges the child i+1 of a node and the item separating them into the child i.
+func merge[T any](n *node[T], i int) {
+	left, right := n.children[i], n.children[i+1]
+	left.items = append(left.items, n.items[i])
+	left.items = append(left.items, right.items...)
+	left.children = append(left.children, right.children...)
+	left.size += 1 + right.size
+	n.items = slices.Delete(n.items, i, i+1)
+	n.children = slices.Delete(n.children, i+1, i+2)
+}
+
+// subtreeSize returns the number of elements in the subtree of a node from the sizes of its children.
+func subtreeSize[T any](n *node[T]) int {
+	size := len(n.items)
+	for _, c := range n.children {
+		size += c.size
+	}
+	return size
+}
+
+// ascend yields the items of the subtree of a node in ascending order,
+// skipping the first skip items, and returns false if yield stopped.
+func ascend[T any](n *node[T], skip int, yield func(T) bool) bool {
+	if n.leaf() {
+		for _, v := range n.items[min(skip, len(n.items)):] {
+			if !yield(v) {
+				return false
+			}
+		}
+		return true
+	}
+	for j, c := range n.children {
+		if skip < c.size {
+			if !ascend(c, skip, yield) {
+				return false
+			}
+			skip = 0
+		} else {
+			skip -= c.size
+		}
+		if j == len(n.items) {
+			break
+		}
+		if skip > 0 {
+			skip--
+		} else if !yield(n.items[j]) {
+			return false
+		}
+	}
+	return true
+}
+
+// descend yields the items of the subtree of a node in descending order,
+// and returns false if yield stopped.
+func descend[T any](n *node[T], yield func(T) bool) bool {
+	for j := len(n.items); j >= 0; j-- {
+		if !n.leaf() && !descend(n.children[j], yield) {
+			return false
+		}
+		if j > 0 && !yield(n.items[j-1]) {
+			return false
+		}
+	}
+	return true
+}
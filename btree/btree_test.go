@@ -0,0 +1,188 @@
+package btree
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/charbz/gophers/collection"
+)
+
+// check verifies the structure of the tree: sorted items, node sizes,
+// node fill and leaves at the same depth.
+func check[T any](t *testing.T, tree *BTree[T]) {
+	t.Helper()
+	if tree.root == nil {
+		return
+	}
+	leafDepth := -1
+	var walk func(n *node[T], depth int, root bool)
+	walk = func(n *node[T], depth int, root bool) {
+		if !root && (len(n.items) < degree-1 || len(n.items) > 2*degree-1) {
+			t.Fatalf("node holds %d items, want between %d and %d", len(n.items), degree-1, 2*degree-1)
+		}
+		if n.size != subtreeSize(n) {
+			t.Fatalf("node size = %d, want %d", n.size, subtreeSize(n))
+		}
+		if n.leaf() {
+			if leafDepth == -1 {
+				leafDepth = depth
+			} else if depth != leafDepth {
+				t.Fatalf("leaf at depth %d, want %d", depth, leafDepth)
+			}
+			return
+		}
+		if len(n.children) != len(n.items)+1 {
+			t.Fatalf("node has %d children for %d items", len(n.children), len(n.items))
+		}
+		for _, c := range n.children {
+			walk(c, depth+1, false)
+		}
+	}
+	walk(tree.root, 0, true)
+	if !slices.IsSortedFunc(tree.ToSlice(), tree.compare) {
+		t.Fatalf("ToSlice() = %v is not sorted", tree.ToSlice())
+	}
+}
+
+func TestBTree_ImplementsCollections(t *testing.T) {
+	var _ collection.OrderedCollection[int] = New[int]()
+	var _ collection.MutableCollection[int] = New[int]()
+}
+
+func TestBTree_AgainstSortedSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tree := New[int]()
+	var want []int
+	for i := range 20000 {
+		v := r.Intn(2000)
+		if i%3 == 2 {
+			removed := tree.Remove(v)
+			j, found := slices.BinarySearch(want, v)
+			if removed != found {
+				t.Fatalf("Remove(%d) = %v, want %v", v, removed, found)
+			}
+			if found {
+				want = slices.Delete(want, j, j+1)
+			}
+		} else {
+			tree.Add(v)
+			j, _ := slices.BinarySearch(want, v)
+			want = slices.Insert(want, j, v)
+		}
+		if i%1000 == 0 {
+			check(t, tree)
+		}
+	}
+	check(t, tree)
+	if !slices.Equal(tree.ToSlice(), want) {
+		t.Fatalf("ToSlice() does not match the reference")
+	}
+	for _, i := range []int{0, 1, len(want) / 2, len(want) - 1} {
+		if got := tree.At(i); got != want[i] {
+			t.Errorf("At(%d) = %v, want %v", i, got, want[i])
+		}
+	}
+	for _, v := range []int{-1, 0, 500, 1999, 2000} {
+		rank, _ := slices.BinarySearch(want, v)
+		if got := tree.Rank(v); got != rank {
+			t.Errorf("Rank(%d) = %v, want %v", v, got, rank)
+		}
+	}
+	for want[0] != want[len(want)-1] {
+		tree.Remove(want[0])
+		want = want[1:]
+	}
+	for len(want) > 0 {
+		tree.Remove(want[0])
+		want = want[1:]
+	}
+	if !tree.IsEmpty() || tree.Length() != 0 {
+		t.Errorf("Length() = %v after removing every element, want %v", tree.Length(), 0)
+	}
+}
+
+func TestBTree_Iteration(t *testing.T) {
+	values := make([]int, 500)
+	for i := range values {
+		values[i] = (i * 7919) % 500
+	}
+	tree := New(values)
+	for i, v := range tree.All() {
+		if v != i {
+			t.Fatalf("All() yielded %d, %d, want %d, %d", i, v, i, i)
+		}
+	}
+	want := 499
+	for i, v := range tree.Backward() {
+		if i != want || v != want {
+			t.Fatalf("Backward() yielded %d, %d, want %d, %d", i, v, want, want)
+		}
+		want--
+	}
+	if got := slices.Collect(tree.Range(100, 104)); !slices.Equal(got, []int{100, 101, 102, 103}) {
+		t.Errorf("Range() = %v, want %v", got, []int{100, 101, 102, 103})
+	}
+	slice := tree.Slice(250, 253).(*BTree[int])
+	if !slices.Equal(slice.ToSlice(), []int{250, 251, 252}) {
+		t.Errorf("Slice() = %v, want %v", slice, []int{250, 251, 252})
+	}
+	if lo, _ := tree.Min(); lo != 0 {
+		t.Errorf("Min() = %v, want %v", lo, 0)
+	}
+	if hi, _ := tree.Max(); hi != 499 {
+		t.Errorf("Max() = %v, want %v", hi, 499)
+	}
+}
+
+func TestBTree_EqualElements(t *testing.T) {
+	type user struct {
+		name string
+		age  int
+	}
+	byAge := func(a, b user) int { return cmp.Compare(a.age, b.age) }
+	tree := NewFunc(byAge, []user{{"ann", 30}, {"bob", 20}, {"cat", 30}, {"dan", 25}})
+	tree.Add(user{"eve", 30})
+	var names []string
+	for u := range tree.Values() {
+		names = append(names, u.name)
+	}
+	if !slices.Equal(names, []string{"bob", "dan", "ann", "cat", "eve"}) {
+		t.Errorf("Values() = %v, want %v", names, []string{"bob", "dan", "ann", "cat", "eve"})
+	}
+	n := tree.RemoveWhere(func(u user) bool { return u.name == "cat" })
+	if n != 1 || tree.Length() != 4 || !tree.Contains(user{age: 30}) {
+		t.Errorf("RemoveWhere() = %v leaving %v, want %v", n, tree.Length(), 1)
+	}
+}
+
+func TestBTree_Errors(t *testing.T) {
+	tree := New[string]()
+	if _, err := tree.Min(); err != collection.EmptyCollectionError {
+		t.Errorf("Min() error = %v, want %v", err, collection.EmptyCollectionError)
+	}
+	if _, err := tree.Choice(); err != collection.EmptyCollectionError {
+		t.Errorf("Choice() error = %v, want %v", err, collection.EmptyCollectionError)
+	}
+	defer func() {
+		if r := recover(); r != collection.IndexOutOfBoundsError {
+			t.Errorf("At() panic = %v, want %v", r, collection.IndexOutOfBoundsError)
+		}
+	}()
+	tree.At(0)
+}
+
+func TestBTree_String(t *testing.T) {
+	if got := New([]int{3, 1, 2}).String(); got != "BTree(int) [1 2 3]" {
+		t.Errorf("String() = %v, want %v", got, "BTree(int) [1 2 3]")
+	}
+}
+
+func TestBTree_Reverse(t *testing.T) {
+	tree := New([]int{3, 1, 4, 2})
+	got := collection.Reverse[int](tree)
+	if !slices.Equal(slices.Collect(got.Values()), []int{4, 3, 2, 1}) {
+		t.Errorf("Reverse() = %v, want %v", got, []int{4, 3, 2, 1})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package cache implements fixed capacity caches built on the containers of this library:
+// LRU evicts the least recently used entry and LFU evicts the least frequently used entry.
+// Entries are kept in the nodes of a list.List, which are indexed by key in a set.SetBy,
+// so that lookups, insertions and evictions run in O(1) time.
+//
+// Caches implement the Collection interface over their entries, which makes them usable
+// with the functions of the collection package. Caches are not safe for concurrent use.
+package cache
+
+import (
+	"github.com/charbz/gophers/list"
+)
+
+// Entry is a key and its value held by a cache.
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// entry is an entry of a cache along with its node in the list ordering
+// the entries for eviction, and the number of times it was used.
+type entry[K comparable, V any] struct {
+	Entry[K, V]
+	node      *list.Node[*entry[K, V]]
+	frequency int
+}
+
+// entryKey returns the key of an entry, which indexes entries in a set.SetBy.
+func entryKey[K comparable, V any](e *entry[K, V]) K {
+	return e.Key
+}
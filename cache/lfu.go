@@ -0,0 +1,271 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package cache
+
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+
+	"github.com/charbz/gophers/collection"
+	"github.com/charbz/gophers/list"
+	"github.com/charbz/gophers/set"
+)
+
+// LFU is a cache holding up to a fixed number of entries, which evicts the least
+// frequently used entry to make room for a new one, breaking ties by evicting the
+// least recently used of them. Getting or putting an entry uses it.
+type LFU[K comparable, V any] struct {
+	capacity int
+	buckets  map[int]*list.List[*entry[K, V]] // entries by frequency, from least to most recently used
+	minFreq  int
+	index    *set.SetBy[*entry[K, V], K]
+	onEvict  func(K, V)
+}
+
+// NewLFU is a constructor for an empty LFU cache holding up to capacity entries.
+// It panics with an InvalidArgumentError if capacity is not positive.
+//
+// example usage:
+//
+//	c := NewLFU[string, int](2)
+//	c.Put("a", 1)
+//	c.Put("b", 2)
+//	c.Get("a")
+//	c.Put("c", 3)
+//	c.Contains("b")
+//
+// output:
+//
+//	false
+func NewLFU[K comparable, V any](capacity int) *LFU[K, V] {
+	if capacity < 1 {
+		panic(collection.InvalidArgumentError)
+	}
+	return &LFU[K, V]{
+		capacity: capacity,
+		buckets:  make(map[int]*list.List[*entry[K, V]]),
+		index:    set.NewSetBy(entryKey[K, V]),
+	}
+}
+
+// OnEvict sets a function called with every entry evicted to make room for a new one,
+// for example to release resources held by the value, and returns the cache.
+// It is not called for entries removed with Remove or Clear.
+func (c *LFU[K, V]) OnEvict(f func(K, V)) *LFU[K, V] {
+	c.onEvict = f
+	return c
+}
+
+// The following methods implement
+// the Collection interface.
+
+// Add puts the entry in the cache, see Put.
+func (c *LFU[K, V]) Add(e Entry[K, V]) {
+	c.Put(e.Key, e.Value)
+}
+
+// Length returns the number of entries in the cache.
+func (c *LFU[K, V]) Length() int {
+	return c.index.Length()
+}
+
+// New returns a new cache with the same capacity and eviction function,
+// holding the given entries.
+func (c *LFU[K, V]) New(s ...[]Entry[K, V]) collection.Collection[Entry[K, V]] {
+	r := NewLFU[K, V](c.capacity).OnEvict(c.onEvict)
+	for _, slice := range s {
+		for _, e := range slice {
+			r.Add(e)
+		}
+	}
+	return r
+}
+
+// Random returns a random entry without using it.
+// It panics with an EmptyCollectionError if the cache is empty,
+// use Choice to get an error instead.
+func (c *LFU[K, V]) Random() Entry[K, V] {
+	if c.Length() == 0 {
+		panic(collection.EmptyCollectionError)
+	}
+	return c.index.Random().Entry
+}
+
+// Choice is an alias for collection.Choice
+func (c *LFU[K, V]) Choice() (Entry[K, V], error) {
+	return collection.Choice(c)
+}
+
+// Values returns an iterator over the entries of the cache from the least to
+// the most frequently used, without using them. Entries used equally often are
+// ordered from the least to the most recently used, which is the eviction order.
+func (c *LFU[K, V]) Values() iter.Seq[Entry[K, V]] {
+	return func(yield func(Entry[K, V]) bool) {
+		for e := range c.entries() {
+			if !yield(e.Entry) {
+				return
+			}
+		}
+	}
+}
+
+// The following methods are specific to the LFU type.
+
+// All returns an iterator over the keys and values of the cache
+// in the order of Values, without using them.
+func (c *LFU[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := range c.entries() {
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Capacity returns the maximum number of entries the cache can hold.
+func (c *LFU[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Clear removes all entries from the cache.
+func (c *LFU[K, V]) Clear() {
+	clear(c.buckets)
+	c.minFreq = 0
+	c.index = set.NewSetBy(entryKey[K, V])
+}
+
+// Contains returns true if the key is in the cache, without using it.
+func (c *LFU[K, V]) Contains(k K) bool {
+	_, ok := c.index.Get(k)
+	return ok
+}
+
+// Frequency returns the number of times the key was used since it was added
+// to the cache, or 0 if the key is not in the cache.
+func (c *LFU[K, V]) Frequency(k K) int {
+	e, ok := c.index.Get(k)
+	if !ok {
+		return 0
+	}
+	return e.frequency
+}
+
+// Get returns the value associated with the key and reports whether
+// the key is in the cache. The use increments the frequency of the entry.
+func (c *LFU[K, V]) Get(k K) (V, bool) {
+	e, ok := c.index.Get(k)
+	if !ok {
+		return *new(V), false
+	}
+	c.touch(e)
+	return e.Value, true
+}
+
+// Peek is like Get but does not use the entry.
+func (c *LFU[K, V]) Peek(k K) (V, bool) {
+	e, ok := c.index.Get(k)
+	if !ok {
+		return *new(V), false
+	}
+	return e.Value, true
+}
+
+// Put associates the value with the key, incrementing the frequency of the entry,
+// and reports whether the key was already in the cache. If the cache is full and
+// the key is new, the least frequently used entry is evicted first.
+func (c *LFU[K, V]) Put(k K, v V) bool {
+	if e, ok := c.index.Get(k); ok {
+		e.Value = v
+		c.touch(e)
+		return true
+	}
+	if c.Length() == c.capacity {
+		c.evict()
+	}
+	e := &entry[K, V]{Entry: Entry[K, V]{Key: k, Value: v}, frequency: 1}
+	e.node = c.bucket(1).AddNode(e)
+	c.minFreq = 1
+	c.index.Add(e)
+	return false
+}
+
+// Remove removes the key from the cache and reports whether it was present.
+func (c *LFU[K, V]) Remove(k K) bool {
+	e, ok := c.index.Get(k)
+	if !ok {
+		return false
+	}
+	c.unlink(e)
+	c.index.Remove(e)
+	return true
+}
+
+// implement the Stringer interface
+func (c *LFU[K, V]) String() string {
+	return fmt.Sprintf("LFU(%T, %T) %v", *new(K), *new(V), collection.AppendToSlice(c, nil))
+}
+
+// bucket returns the list of entries used f times, creating it if needed.
+func (c *LFU[K, V]) bucket(f int) *list.List[*entry[K, V]] {
+	b, ok := c.buckets[f]
+	if !ok {
+		b = list.NewList[*entry[K, V]]()
+		c.buckets[f] = b
+	}
+	return b
+}
+
+// entries returns an iterator over the entries in eviction order.
+func (c *LFU[K, V]) entries() iter.Seq[*entry[K, V]] {
+	return func(yield func(*entry[K, V]) bool) {
+		for _, f := range slices.Sorted(maps.Keys(c.buckets)) {
+			for e := range c.buckets[f].Values() {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// evict removes the least frequently used entry and calls the eviction function.
+func (c *LFU[K, V]) evict() {
+	e, err := c.buckets[c.minFreq].Head()
+	if err != nil {
+		return
+	}
+	c.unlink(e)
+	c.index.Remove(e)
+	if c.onEvict != nil {
+		c.onEvict(e.Key, e.Value)
+	}
+}
+
+// touch moves an entry to the bucket of the next frequency.
+func (c *LFU[K, V]) touch(e *entry[K, V]) {
+	f := e.frequency
+	c.unlink(e)
+	if c.minFreq == f {
+		if _, ok := c.buckets[f]; !ok {
+			c.minFreq = f + 1
+		}
+	}
+	e.frequency = f + 1
+	e.node = c.bucket(f + 1).AddNode(e)
+}
+
+// unlink removes an entry from its bucket, dropping the bucket if it becomes empty.
+// The minimum frequency is left for the caller to maintain: after a Remove it may be stale,
+// but the cache is then below capacity and the next Put of a new key resets it to 1.
+func (c *LFU[K, V]) unlink(e *entry[K, V]) {
+	b := c.buckets[e.frequency]
+	b.RemoveNode(e.node)
+	if b.IsEmpty() {
+		delete(c.buckets, e.frequency)
+	}
+}
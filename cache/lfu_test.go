@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/charbz/gophers/collection"
+)
+
+func TestLFU_ImplementsCollection(t *testing.T) {
+	var _ collection.Collection[Entry[string, int]] = NewLFU[string, int](1)
+}
+
+func TestLFU_Eviction(t *testing.T) {
+	var evicted []string
+	c := NewLFU[string, int](3).OnEvict(func(k string, v int) {
+		evicted = append(evicted, k)
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Peek("c")
+	c.Put("d", 4) // evicts c, the least frequently used
+	c.Put("e", 5) // evicts d, used as often as b but less recently
+	if !slices.Equal(evicted, []string{"c", "d"}) {
+		t.Errorf("evicted = %v, want %v", evicted, []string{"c", "d"})
+	}
+	if got := keys(c); !slices.Equal(got, []string{"e", "b", "a"}) {
+		t.Errorf("Values() = %v, want %v", got, []string{"e", "b", "a"})
+	}
+	if c.Frequency("a") != 3 || c.Frequency("c") != 0 {
+		t.Errorf("Frequency() = %v, %v, want %v, %v", c.Frequency("a"), c.Frequency("c"), 3, 0)
+	}
+	if !c.Put("e", 50) {
+		t.Errorf("Put() = false for a present key, want true")
+	}
+	if v, ok := c.Get("e"); !ok || v != 50 {
+		t.Errorf("Get() = %v, %v, want %v, %v", v, ok, 50, true)
+	}
+	if got := keys(c); !slices.Equal(got, []string{"b", "a", "e"}) {
+		t.Errorf("Values() = %v, want %v", got, []string{"b", "a", "e"})
+	}
+}
+
+func TestLFU_Remove(t *testing.T) {
+	var evicted []int
+	c := NewLFU[int, string](2).OnEvict(func(k int, _ string) { evicted = append(evicted, k) })
+	c.Put(1, "one")
+	c.Put(2, "two")
+	c.Get(2)
+	if !c.Remove(1) || c.Remove(1) {
+		t.Errorf("Remove() did not report the presence of the key")
+	}
+	c.Get(2)
+	c.Put(3, "three")
+	c.Get(3)
+	c.Remove(3)
+	c.Put(4, "four")
+	c.Put(5, "five") // evicts 4, the least frequent entry once 3 was removed
+	if !slices.Equal(evicted, []int{4}) {
+		t.Errorf("evicted = %v, want %v", evicted, []int{4})
+	}
+	c.Clear()
+	if c.Length() != 0 || c.Contains(2) {
+		t.Errorf("Length() = %v after Clear(), want %v", c.Length(), 0)
+	}
+	c.Put(6, "six")
+	if got := keys(c); !slices.Equal(got, []int{6}) {
+		t.Errorf("Values() = %v, want %v", got, []int{6})
+	}
+}
+
+func TestLFU_String(t *testing.T) {
+	c := NewLFU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	if got := c.String(); got != "LFU(string, int) [{b 2} {a 1}]" {
+		t.Errorf("String() = %v, want %v", got, "LFU(string, int) [{b 2} {a 1}]")
+	}
+}
+
+func TestNewLFU_InvalidCapacity(t *testing.T) {
+	defer func() {
+		if r := recover(); r != collection.InvalidArgumentError {
+			t.Errorf("NewLFU() panic = %v, want %v", r, collection.InvalidArgumentError)
+		}
+	}()
+	NewLFU[string, int](-1)
+}
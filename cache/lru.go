@@ -0,0 +1,207 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package cache
+
+import (
+	"fmt"
+	"iter"
+	"math/rand"
+
+	"github.com/charbz/gophers/collection"
+	"github.com/charbz/gophers/list"
+	"github.com/charbz/gophers/set"
+)
+
+// LRU is a cache holding up to a fixed number of entries, which evicts the least
+// recently used entry to make room for a new one. Getting or putting an entry uses it.
+type LRU[K comparable, V any] struct {
+	capacity int
+	order    *list.List[*entry[K, V]] // from least to most recently used
+	index    *set.SetBy[*entry[K, V], K]
+	onEvict  func(K, V)
+}
+
+// NewLRU is a constructor for an empty LRU cache holding up to capacity entries.
+// It panics with an InvalidArgumentError if capacity is not positive.
+//
+// example usage:
+//
+//	c := NewLRU[string, int](2)
+//	c.Put("a", 1)
+//	c.Put("b", 2)
+//	c.Get("a")
+//	c.Put("c", 3)
+//	c.Contains("b")
+//
+// output:
+//
+//	false
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	if capacity < 1 {
+		panic(collection.InvalidArgumentError)
+	}
+	return &LRU[K, V]{
+		capacity: capacity,
+		order:    list.NewList[*entry[K, V]](),
+		index:    set.NewSetBy(entryKey[K, V]),
+	}
+}
+
+// OnEvict sets a function called with every entry evicted to make room for a new one,
+// for example to release resources held by the value, and returns the cache.
+// It is not called for entries removed with Remove or Clear.
+func (c *LRU[K, V]) OnEvict(f func(K, V)) *LRU[K, V] {
+	c.onEvict = f
+	return c
+}
+
+// The following methods implement
+// the Collection interface.
+
+// Add puts the entry in the cache, see Put.
+func (c *LRU[K, V]) Add(e Entry[K, V]) {
+	c.Put(e.Key, e.Value)
+}
+
+// Length returns the number of entries in the cache.
+func (c *LRU[K, V]) Length() int {
+	return c.order.Length()
+}
+
+// New returns a new cache with the same capacity and eviction function,
+// holding the given entries.
+func (c *LRU[K, V]) New(s ...[]Entry[K, V]) collection.Collection[Entry[K, V]] {
+	r := NewLRU[K, V](c.capacity).OnEvict(c.onEvict)
+	for _, slice := range s {
+		for _, e := range slice {
+			r.Add(e)
+		}
+	}
+	return r
+}
+
+// Random returns a random entry without using it.
+// It panics with an EmptyCollectionError if the cache is empty,
+// use Choice to get an error instead.
+func (c *LRU[K, V]) Random() Entry[K, V] {
+	if c.Length() == 0 {
+		panic(collection.EmptyCollectionError)
+	}
+	return c.order.At(rand.Intn(c.Length())).Entry
+}
+
+// Choice is an alias for collection.Choice
+func (c *LRU[K, V]) Choice() (Entry[K, V], error) {
+	return collection.Choice(c)
+}
+
+// Values returns an iterator over the entries of the cache
+// from the least to the most recently used, without using them.
+func (c *LRU[K, V]) Values() iter.Seq[Entry[K, V]] {
+	return func(yield func(Entry[K, V]) bool) {
+		for e := range c.order.Values() {
+			if !yield(e.Entry) {
+				return
+			}
+		}
+	}
+}
+
+// The following methods are specific to the LRU type.
+
+// All returns an iterator over the keys and values of the cache
+// from the least to the most recently used, without using them.
+func (c *LRU[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := range c.order.Values() {
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Capacity returns the maximum number of entries the cache can hold.
+func (c *LRU[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Clear removes all entries from the cache.
+func (c *LRU[K, V]) Clear() {
+	c.order.Clear()
+	c.index = set.NewSetBy(entryKey[K, V])
+}
+
+// Contains returns true if the key is in the cache, without using it.
+func (c *LRU[K, V]) Contains(k K) bool {
+	_, ok := c.index.Get(k)
+	return ok
+}
+
+// Get returns the value associated with the key and reports whether
+// the key is in the cache. The entry becomes the most recently used.
+func (c *LRU[K, V]) Get(k K) (V, bool) {
+	e, ok := c.index.Get(k)
+	if !ok {
+		return *new(V), false
+	}
+	c.order.MoveToBack(e.node)
+	return e.Value, true
+}
+
+// Peek is like Get but does not use the entry.
+func (c *LRU[K, V]) Peek(k K) (V, bool) {
+	e, ok := c.index.Get(k)
+	if !ok {
+		return *new(V), false
+	}
+	return e.Value, true
+}
+
+// Put associates the value with the key, making the entry the most recently used,
+// and reports whether the key was already in the cache. If the cache is full and
+// the key is new, the least recently used entry is evicted first.
+func (c *LRU[K, V]) Put(k K, v V) bool {
+	if e, ok := c.index.Get(k); ok {
+		e.Value = v
+		c.order.MoveToBack(e.node)
+		return true
+	}
+	if c.Length() == c.capacity {
+		c.evict()
+	}
+	e := &entry[K, V]{Entry: Entry[K, V]{Key: k, Value: v}}
+	e.node = c.order.AddNode(e)
+	c.index.Add(e)
+	return false
+}
+
+// Remove removes the key from the cache and reports whether it was present.
+func (c *LRU[K, V]) Remove(k K) bool {
+	e, ok := c.index.Get(k)
+	if !ok {
+		return false
+	}
+	c.order.RemoveNode(e.node)
+	c.index.Remove(e)
+	return true
+}
+
+// implement the Stringer interface
+func (c *LRU[K, V]) String() string {
+	return fmt.Sprintf("LRU(%T, %T) %v", *new(K), *new(V), collection.AppendToSlice(c, nil))
+}
+
+// evict removes the least recently used entry and calls the eviction function.
+func (c *LRU[K, V]) evict() {
+	e, err := c.order.Dequeue()
+	if err != nil {
+		return
+	}
+	c.index.Remove(e)
+	if c.onEvict != nil {
+		c.onEvict(e.Key, e.Value)
+	}
+}
@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/charbz/gophers/collection"
+)
+
+// keys returns the keys of the entries of a cache in iteration order.
+func keys[K comparable, V any](c collection.Collection[Entry[K, V]]) []K {
+	var r []K
+	for e := range c.Values() {
+		r = append(r, e.Key)
+	}
+	return r
+}
+
+func TestLRU_ImplementsCollection(t *testing.T) {
+	var _ collection.Collection[Entry[string, int]] = NewLRU[string, int](1)
+}
+
+func TestLRU_Eviction(t *testing.T) {
+	var evicted []string
+	c := NewLRU[string, int](3).OnEvict(func(k string, v int) {
+		evicted = append(evicted, k)
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Errorf("Get() = %v, %v, want %v, %v", v, ok, 1, true)
+	}
+	if _, ok := c.Peek("b"); !ok {
+		t.Errorf("Peek() = _, %v, want %v", ok, true)
+	}
+	c.Put("d", 4) // evicts b, which Peek did not use
+	if got := keys(c); !slices.Equal(got, []string{"c", "a", "d"}) {
+		t.Errorf("Values() = %v, want %v", got, []string{"c", "a", "d"})
+	}
+	if !c.Put("c", 30) {
+		t.Errorf("Put() = false for a present key, want true")
+	}
+	c.Put("e", 5) // evicts a
+	if !slices.Equal(evicted, []string{"b", "a"}) {
+		t.Errorf("evicted = %v, want %v", evicted, []string{"b", "a"})
+	}
+	if v, _ := c.Peek("c"); v != 30 {
+		t.Errorf("Peek() = %v, want %v", v, 30)
+	}
+	if c.Length() != c.Capacity() {
+		t.Errorf("Length() = %v, want %v", c.Length(), c.Capacity())
+	}
+}
+
+func TestLRU_Remove(t *testing.T) {
+	evictions := 0
+	c := NewLRU[int, string](2).OnEvict(func(int, string) { evictions++ })
+	c.Put(1, "one")
+	c.Put(2, "two")
+	if !c.Remove(1) || c.Remove(1) {
+		t.Errorf("Remove() did not report the presence of the key")
+	}
+	c.Put(3, "three")
+	if got := keys(c); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("Values() = %v, want %v", got, []int{2, 3})
+	}
+	c.Clear()
+	if c.Length() != 0 || c.Contains(2) {
+		t.Errorf("Length() = %v after Clear(), want %v", c.Length(), 0)
+	}
+	if _, err := c.Choice(); err != collection.EmptyCollectionError {
+		t.Errorf("Choice() error = %v, want %v", err, collection.EmptyCollectionError)
+	}
+	if evictions != 0 {
+		t.Errorf("evictions = %v, want %v", evictions, 0)
+	}
+}
+
+func TestLRU_Collection(t *testing.T) {
+	c := NewLRU[string, int](2)
+	c.Add(Entry[string, int]{"a", 1})
+	c.Add(Entry[string, int]{"b", 2})
+	c.Add(Entry[string, int]{"c", 3})
+	evens := collection.Filter(c, func(e Entry[string, int]) bool { return e.Value%2 == 0 })
+	if got := keys(evens); !slices.Equal(got, []string{"b"}) {
+		t.Errorf("Filter() = %v, want %v", got, []string{"b"})
+	}
+	if r, ok := evens.(*LRU[string, int]); !ok || r.Capacity() != 2 {
+		t.Errorf("Filter() returned %T, want an LRU with the same capacity", evens)
+	}
+	for k, v := range c.All() {
+		if v2, _ := c.Peek(k); v != v2 {
+			t.Errorf("All() yielded %v, %v, want %v, %v", k, v, k, v2)
+		}
+	}
+	if got := c.String(); got != "LRU(string, int) [{b 2} {c 3}]" {
+		t.Errorf("String() = %v, want %v", got, "LRU(string, int) [{b 2} {c 3}]")
+	}
+}
+
+func TestNewLRU_InvalidCapacity(t *testing.T) {
+	defer func() {
+		if r := recover(); r != collection.InvalidArgumentError {
+			t.Errorf("NewLRU() panic = %v, want %v", r, collection.InvalidArgumentError)
+		}
+	}()
+	NewLRU[string, int](0)
+}
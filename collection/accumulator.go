@@ -0,0 +1,240 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package collection
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Accumulator maintains a running aggregate, such as a sum, a count or a maximum,
+// as elements are added and removed, so that the current value is available in O(1)
+// time instead of being recomputed from every element.
+//
+// It is configured with an add function folding an element into the aggregate and,
+// for aggregates that can be undone such as sums and counts, a remove function taking
+// an element out of it. Aggregates that cannot be undone, such as minimums and maximums,
+// have no remove function. The optional combine function merges two aggregates, which
+// allows aggregating partitions of the data independently.
+//
+// Use NewAccumulated to keep an accumulator in sync with a mutable collection.
+type Accumulator[T, A any] struct {
+	init    A
+	value   A
+	add     func(A, T) A
+	remove  func(A, T) A
+	combine func(A, A) A
+}
+
+// NewAccumulator returns an accumulator holding the initial value, which must be
+// the aggregate of no elements. The remove and combine functions may be nil.
+//
+// example usage:
+//
+//	sum := NewAccumulator(0,
+//	  func(acc, v int) int { return acc + v },
+//	  func(acc, v int) int { return acc - v },
+//	  func(a, b int) int { return a + b },
+//	)
+//	sum.Add(3)
+//	sum.Add(4)
+//	sum.Remove(3)
+//	sum.Value()
+//
+// output:
+//
+//	4
+func NewAccumulator[T, A any](init A, add, remove func(A, T) A, combine func(A, A) A) *Accumulator[T, A] {
+	return &Accumulator[T, A]{init: init, value: init, add: add, remove: remove, combine: combine}
+}
+
+// Add folds the element into the aggregate.
+func (a *Accumulator[T, A]) Add(v T) {
+	a.value = a.add(a.value, v)
+}
+
+// Remove takes the element out of the aggregate. It panics with an
+// InvalidArgumentError if the accumulator has no remove function.
+func (a *Accumulator[T, A]) Remove(v T) {
+	if a.remove == nil {
+		panic(InvalidArgumentError)
+	}
+	a.value = a.remove(a.value, v)
+}
+
+// CanRemove reports whether the accumulator has a remove function.
+func (a *Accumulator[T, A]) CanRemove() bool {
+	return a.remove != nil
+}
+
+// Merge combines the aggregate of the other accumulator into this one. It panics
+// with an InvalidArgumentError if the accumulator has no combine function.
+func (a *Accumulator[T, A]) Merge(other *Accumulator[T, A]) {
+	if a.combine == nil {
+		panic(InvalidArgumentError)
+	}
+	a.value = a.combine(a.value, other.value)
+}
+
+// Reset sets the aggregate back to its initial value.
+func (a *Accumulator[T, A]) Reset() {
+	a.value = a.init
+}
+
+// Value returns the current aggregate.
+func (a *Accumulator[T, A]) Value() A {
+	return a.value
+}
+
+// Accumulated wraps a mutable collection and keeps an accumulator in sync with the
+// elements added to and removed from the collection through it. When the accumulator has
+// no remove function, removing elements recomputes the aggregate from the remaining ones.
+//
+// Operations performed directly on the wrapped collection are not accumulated.
+type Accumulated[T, A any] struct {
+	c   MutableCollection[T]
+	acc *Accumulator[T, A]
+}
+
+// NewAccumulated returns a view of the collection that maintains the accumulator.
+// The accumulator is reset and fed with the current elements of the collection.
+//
+// example usage:
+//
+//	total := NewAccumulated[int](NewComparableList([]int{1,2,3}), NewAccumulator(0,
+//	  func(acc, v int) int { return acc + v },
+//	  func(acc, v int) int { return acc - v },
+//	  nil,
+//	))
+//	total.Add(4)
+//	total.Remove(1)
+//	total.Value()
+//
+// output:
+//
+//	9
+func NewAccumulated[T, A any](c MutableCollection[T], acc *Accumulator[T, A]) *Accumulated[T, A] {
+	a := &Accumulated[T, A]{c: c, acc: acc}
+	a.recompute()
+	return a
+}
+
+// The following methods implement
+// the Collection interface.
+
+// Add adds a value to the collection. The value is only accumulated if the
+// collection grew, collections such as Sets may ignore or replace it otherwise,
+// in which case the aggregate is recomputed.
+func (a *Accumulated[T, A]) Add(v T) {
+	n := a.c.Length()
+	a.c.Add(v)
+	if a.c.Length() == n+1 {
+		a.acc.Add(v)
+	} else {
+		a.recompute()
+	}
+}
+
+// Length returns the length of the collection.
+func (a *Accumulated[T, A]) Length() int {
+	return a.c.Length()
+}
+
+// New returns a new collection constructed by the wrapped collection.
+// The new collection is not accumulated.
+func (a *Accumulated[T, A]) New(s ...[]T) Collection[T] {
+	return a.c.New(s...)
+}
+
+// Random returns a random element of the collection.
+func (a *Accumulated[T, A]) Random() T {
+	return a.c.Random()
+}
+
+// Values returns an iterator over the values of the collection.
+func (a *Accumulated[T, A]) Values() iter.Seq[T] {
+	return a.c.Values()
+}
+
+// The following methods implement
+// the MutableCollection interface.
+
+// AddAll adds all the given values to the collection. As with Add, the values are
+// only accumulated if the collection grew by all of them, the aggregate is recomputed
+// otherwise.
+func (a *Accumulated[T, A]) AddAll(v ...T) {
+	n := a.c.Length()
+	a.c.AddAll(v...)
+	if a.c.Length() != n+len(v) {
+		a.recompute()
+		return
+	}
+	for _, e := range v {
+		a.acc.Add(e)
+	}
+}
+
+// Clear removes all elements from the collection.
+func (a *Accumulated[T, A]) Clear() {
+	a.c.Clear()
+	a.acc.Reset()
+}
+
+// Remove removes a value from the collection and returns true if it was present.
+func (a *Accumulated[T, A]) Remove(v T) bool {
+	if !a.c.Remove(v) {
+		return false
+	}
+	if a.acc.CanRemove() {
+		a.acc.Remove(v)
+	} else {
+		a.recompute()
+	}
+	return true
+}
+
+// RemoveWhere removes all elements that satisfy the predicate
+// and returns the number of elements removed.
+func (a *Accumulated[T, A]) RemoveWhere(f func(T) bool) int {
+	if !a.acc.CanRemove() {
+		n := a.c.RemoveWhere(f)
+		if n > 0 {
+			a.recompute()
+		}
+		return n
+	}
+	return a.c.RemoveWhere(func(v T) bool {
+		if !f(v) {
+			return false
+		}
+		a.acc.Remove(v)
+		return true
+	})
+}
+
+// The following methods are specific to the Accumulated type.
+
+// Value returns the current aggregate of the elements of the collection.
+func (a *Accumulated[T, A]) Value() A {
+	return a.acc.Value()
+}
+
+// Unwrap returns the wrapped collection.
+func (a *Accumulated[T, A]) Unwrap() MutableCollection[T] {
+	return a.c
+}
+
+// String implements the Stringer interface.
+func (a *Accumulated[T, A]) String() string {
+	return fmt.Sprint(a.c)
+}
+
+// recompute resets the accumulator and adds every element of the collection.
+func (a *Accumulated[T, A]) recompute() {
+	a.acc.Reset()
+	for v := range a.c.Values() {
+		a.acc.Add(v)
+	}
+}
@@ -0,0 +1,79 @@
+package collection
+
+import (
+	"math"
+	"testing"
+)
+
+func newSumAccumulator() *Accumulator[int, int] {
+	return NewAccumulator(0,
+		func(acc, v int) int { return acc + v },
+		func(acc, v int) int { return acc - v },
+		func(a, b int) int { return a + b },
+	)
+}
+
+func TestAccumulator(t *testing.T) {
+	sum := newSumAccumulator()
+	sum.Add(3)
+	sum.Add(4)
+	sum.Remove(3)
+	if got := sum.Value(); got != 4 {
+		t.Errorf("Value() = %v, want %v", got, 4)
+	}
+	other := newSumAccumulator()
+	other.Add(10)
+	sum.Merge(other)
+	if got := sum.Value(); got != 14 {
+		t.Errorf("Merge() = %v, want %v", got, 14)
+	}
+	sum.Reset()
+	if got := sum.Value(); got != 0 {
+		t.Errorf("Reset() = %v, want %v", got, 0)
+	}
+
+	maximum := NewAccumulator(math.MinInt, func(acc, v int) int { return max(acc, v) }, nil, nil)
+	defer func() {
+		if r := recover(); r != InvalidArgumentError {
+			t.Errorf("Remove() panic = %v, want %v", r, InvalidArgumentError)
+		}
+	}()
+	maximum.Remove(1)
+}
+
+func TestAccumulated(t *testing.T) {
+	c := &mockMutableCollection[int]{MockCollection[int]{items: []int{1, 2, 3}}}
+	sum := NewAccumulated[int](c, newSumAccumulator())
+	if got := sum.Value(); got != 6 {
+		t.Errorf("NewAccumulated() = %v, want %v", got, 6)
+	}
+	sum.Add(4)
+	sum.AddAll(5, 6)
+	sum.Remove(1)
+	sum.Remove(42)
+	sum.RemoveWhere(func(i int) bool { return i%2 == 0 })
+	if got := sum.Value(); got != 8 {
+		t.Errorf("Value() = %v, want %v", got, 8)
+	}
+	sum.Clear()
+	if got := sum.Value(); got != 0 {
+		t.Errorf("Clear() = %v, want %v", got, 0)
+	}
+}
+
+func TestAccumulated_WithoutRemove(t *testing.T) {
+	c := &mockMutableCollection[int]{MockCollection[int]{items: []int{3, 9, 5}}}
+	maximum := NewAccumulated[int](c, NewAccumulator(math.MinInt, func(acc, v int) int { return max(acc, v) }, nil, nil))
+	maximum.Add(7)
+	if got := maximum.Value(); got != 9 {
+		t.Errorf("Value() = %v, want %v", got, 9)
+	}
+	maximum.Remove(9)
+	if got := maximum.Value(); got != 7 {
+		t.Errorf("Remove() = %v, want %v", got, 7)
+	}
+	maximum.RemoveWhere(func(i int) bool { return i > 4 })
+	if got := maximum.Value(); got != 3 {
+		t.Errorf("RemoveWhere() = %v, want %v", got, 3)
+	}
+}
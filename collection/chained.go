@@ -0,0 +1,193 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package collection
+
+import (
+	"fmt"
+	"iter"
+	"math/rand"
+)
+
+// Chained is an ordered view over several underlying ordered collections.
+// Elements are never copied out of the underlying collections, indexing walks
+// the segments in order and resolves positions across segment boundaries.
+//
+// A Chained is intended for read-mostly concatenation of large collections.
+// Values added to the chain are stored in a buffer owned by the chain and appear
+// after all segments, the underlying collections are never mutated by the chain.
+type Chained[T any] struct {
+	segments []OrderedCollection[T]
+	tail     []T
+}
+
+// NewChained returns a view over the given collections in order.
+//
+// example usage:
+//
+//	a := NewSequence([]int{1,2,3})
+//	b := NewList([]int{4,5})
+//	c := NewChained[int](a, b)
+//	c.At(3)
+//
+// output:
+//
+//	4
+func NewChained[T any](segments ...OrderedCollection[T]) *Chained[T] {
+	return &Chained[T]{segments: segments}
+}
+
+// The following methods implement
+// the Collection interface.
+
+// Add appends a value to the end of the chain.
+func (c *Chained[T]) Add(v T) {
+	c.tail = append(c.tail, v)
+}
+
+// Length returns the total number of elements across all segments.
+func (c *Chained[T]) Length() int {
+	n := len(c.tail)
+	for _, s := range c.segments {
+		n += s.Length()
+	}
+	return n
+}
+
+// New returns a new chain holding the given slices.
+func (c *Chained[T]) New(s ...[]T) Collection[T] {
+	return c.newChained(s...)
+}
+
+// Random returns a random element from the chain.
+func (c *Chained[T]) Random() T {
+	n := c.Length()
+	if n == 0 {
+		return *new(T)
+	}
+	return c.At(rand.Intn(n))
+}
+
+// Values returns an iterator over all values of the chain.
+func (c *Chained[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, s := range c.segments {
+			for v := range s.Values() {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+		for _, v := range c.tail {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// The following methods implement
+// the OrderedCollection interface.
+
+// At returns the element at the given index.
+func (c *Chained[T]) At(index int) T {
+	if index < 0 {
+		panic(IndexOutOfBoundsError)
+	}
+	for _, s := range c.segments {
+		n := s.Length()
+		if index < n {
+			return s.At(index)
+		}
+		index -= n
+	}
+	if index >= len(c.tail) {
+		panic(IndexOutOfBoundsError)
+	}
+	return c.tail[index]
+}
+
+// All returns an index/value iterator over all elements of the chain.
+func (c *Chained[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for v := range c.Values() {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Backward returns an index/value iterator over all elements of the chain in reverse order.
+func (c *Chained[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		offset := c.Length() - len(c.tail)
+		for i := len(c.tail) - 1; i >= 0; i-- {
+			if !yield(offset+i, c.tail[i]) {
+				return
+			}
+		}
+		for i := len(c.segments) - 1; i >= 0; i-- {
+			offset -= c.segments[i].Length()
+			for j, v := range c.segments[i].Backward() {
+				if !yield(offset+j, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Slice returns a new chain over the elements from the start index to the end index.
+// The returned chain references slices of the underlying segments.
+func (c *Chained[T]) Slice(start, end int) OrderedCollection[T] {
+	if start < 0 || end > c.Length() || start > end {
+		panic(IndexOutOfBoundsError)
+	}
+	r := &Chained[T]{}
+	offset := 0
+	for _, s := range c.segments {
+		n := s.Length()
+		lo, hi := max(start, offset), min(end, offset+n)
+		if lo < hi {
+			r.segments = append(r.segments, s.Slice(lo-offset, hi-offset))
+		}
+		offset += n
+	}
+	lo, hi := max(start, offset)-offset, min(end, offset+len(c.tail))-offset
+	if lo < hi {
+		r.tail = c.tail[lo:hi:hi]
+	}
+	return r
+}
+
+// NewOrdered returns a new chain holding the given slices.
+func (c *Chained[T]) NewOrdered(s ...[]T) OrderedCollection[T] {
+	return c.newChained(s...)
+}
+
+// Segments returns the underlying collections of the chain.
+func (c *Chained[T]) Segments() []OrderedCollection[T] {
+	return c.segments
+}
+
+// ToSlice copies all elements of the chain into a new slice.
+func (c *Chained[T]) ToSlice() []T {
+	return AppendToSlice(c, make([]T, 0, c.Length()))
+}
+
+// String implements the Stringer interface.
+func (c *Chained[T]) String() string {
+	return fmt.Sprintf("Chained(%T) %v", *new(T), c.ToSlice())
+}
+
+func (c *Chained[T]) newChained(s ...[]T) *Chained[T] {
+	r := &Chained[T]{}
+	for _, slice := range s {
+		r.tail = append(r.tail, slice...)
+	}
+	return r
+}
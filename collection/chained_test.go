@@ -0,0 +1,116 @@
+package collection
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestChainedImplementsOrderedCollection(t *testing.T) {
+	var c OrderedCollection[int] = NewChained[int](
+		NewMockOrderedCollection([]int{1, 2}),
+		NewMockOrderedCollection([]int{3}),
+	)
+	if c.Length() != 3 {
+		t.Errorf("Length() = %v, want %v", c.Length(), 3)
+	}
+}
+
+func TestChained_At(t *testing.T) {
+	c := NewChained[int](
+		NewMockOrderedCollection([]int{1, 2, 3}),
+		NewMockOrderedCollection([]int{}),
+		NewMockOrderedCollection([]int{4, 5}),
+	)
+	c.Add(6)
+	for i, want := range []int{1, 2, 3, 4, 5, 6} {
+		if got := c.At(i); got != want {
+			t.Errorf("At(%d) = %v, want %v", i, got, want)
+		}
+	}
+	defer func() {
+		if r := recover(); r != IndexOutOfBoundsError {
+			t.Errorf("At() panic = %v, want %v", r, IndexOutOfBoundsError)
+		}
+	}()
+	c.At(6)
+}
+
+func TestChained_Iterators(t *testing.T) {
+	c := NewChained[int](
+		NewMockOrderedCollection([]int{1, 2}),
+		NewMockOrderedCollection([]int{3, 4}),
+	)
+	c.Add(5)
+
+	if got := slices.Collect(c.Values()); !slices.Equal(got, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("Values() = %v, want %v", got, []int{1, 2, 3, 4, 5})
+	}
+
+	var indices, values []int
+	for i, v := range c.Backward() {
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+	if !slices.Equal(indices, []int{4, 3, 2, 1, 0}) || !slices.Equal(values, []int{5, 4, 3, 2, 1}) {
+		t.Errorf("Backward() = %v %v, want %v %v", indices, values, []int{4, 3, 2, 1, 0}, []int{5, 4, 3, 2, 1})
+	}
+
+	for i, v := range c.All() {
+		if i == 2 {
+			break
+		}
+		if v != i+1 {
+			t.Errorf("All() yielded %v at %v, want %v", v, i, i+1)
+		}
+	}
+}
+
+func TestChained_Slice(t *testing.T) {
+	a := NewMockOrderedCollection([]int{1, 2, 3})
+	b := NewMockOrderedCollection([]int{4, 5, 6})
+	c := NewChained[int](a, b)
+	c.Add(7)
+	c.Add(8)
+
+	tests := []struct {
+		name       string
+		start, end int
+		want       []int
+	}{
+		{name: "within a segment", start: 0, end: 2, want: []int{1, 2}},
+		{name: "across segments", start: 2, end: 5, want: []int{3, 4, 5}},
+		{name: "into the tail", start: 5, end: 8, want: []int{6, 7, 8}},
+		{name: "empty", start: 4, end: 4, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := c.Slice(tt.start, tt.end).(*Chained[int]).ToSlice()
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Slice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	a.items[2] = 30
+	if got := c.Slice(2, 4).At(0); got != 30 {
+		t.Errorf("Slice() did not reference the underlying segment, got %v, want %v", got, 30)
+	}
+}
+
+func TestChained_WithCollectionFunctions(t *testing.T) {
+	c := NewChained[int](
+		NewMockOrderedCollection([]int{1, 2, 3}),
+		NewMockOrderedCollection([]int{4, 5, 6}),
+	)
+	got := Filter(c, func(i int) bool { return i%2 == 0 }).(*Chained[int]).ToSlice()
+	if !slices.Equal(got, []int{2, 4, 6}) {
+		t.Errorf("Filter() = %v, want %v", got, []int{2, 4, 6})
+	}
+	_, v := FindLast(c, func(i int) bool { return i < 5 })
+	if v != 4 {
+		t.Errorf("FindLast() = %v, want %v", v, 4)
+	}
+	if got := Reverse(c).(*Chained[int]).ToSlice(); !slices.Equal(got, []int{6, 5, 4, 3, 2, 1}) {
+		t.Errorf("Reverse() = %v, want %v", got, []int{6, 5, 4, 3, 2, 1})
+	}
+}
@@ -10,6 +10,7 @@ package collection
 import (
 	"fmt"
 	"iter"
+	"strings"
 )
 
 // Collection is a generic interface that must be implemented by all collection sub-types.
@@ -31,6 +32,67 @@ func (e *CollectionError) Error() string {
 	return fmt.Sprintf("error %d: %s", e.code, e.msg)
 }
 
+// DuplicateKeyError is returned when a uniqueness constraint is violated.
+// It holds the duplicate keys in the order they were first seen, and for
+// each key the indices at which elements producing that key were found.
+type DuplicateKeyError[K comparable] struct {
+	Keys    []K
+	Indices map[K][]int
+}
+
+func (e *DuplicateKeyError[K]) Error() string {
+	var b strings.Builder
+	b.WriteString("duplicate keys:")
+	for i, k := range e.Keys {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, " %v at %v", k, e.Indices[k])
+	}
+	return b.String()
+}
+
+// TypeAssertionError is returned when the collection produced by an operation
+// is not of the concrete type expected by the caller, for example when a type
+// embedding a collection returns another type from New or NewOrdered.
+type TypeAssertionError struct {
+	Op       string
+	Expected string
+	Actual   string
+}
+
+func (e *TypeAssertionError) Error() string {
+	return fmt.Sprintf("%s: expected a collection of type %s, got %s", e.Op, e.Expected, e.Actual)
+}
+
+// Cast converts the result of the named operation to the concrete type C,
+// returning a TypeAssertionError if it is of another type.
+//
+// example usage:
+//
+//	s, err := Cast[*Sequence[int]]("Filter", Filter(c, f))
+func Cast[C any](op string, v any) (C, error) {
+	c, ok := v.(C)
+	if !ok {
+		return c, &TypeAssertionError{
+			Op:       op,
+			Expected: fmt.Sprintf("%T", c),
+			Actual:   fmt.Sprintf("%T", v),
+		}
+	}
+	return c, nil
+}
+
+// MustCast is like Cast but panics with the TypeAssertionError.
+// It is used by the methods of concrete collections that wrap package functions.
+func MustCast[C any](op string, v any) C {
+	c, err := Cast[C](op, v)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
 var (
 	EmptyCollectionError = &CollectionError{
 		code: 100, msg: "invalid operation on an empty collection",
@@ -41,4 +103,13 @@ var (
 	IndexOutOfBoundsError = &CollectionError{
 		code: 102, msg: "index out of bounds",
 	}
+	InvalidArgumentError = &CollectionError{
+		code: 103, msg: "invalid argument",
+	}
+	OverflowError = &CollectionError{
+		code: 104, msg: "arithmetic overflow",
+	}
+	LengthMismatchError = &CollectionError{
+		code: 105, msg: "collections have different lengths",
+	}
 )
@@ -59,3 +59,27 @@ func TestMockCollectionImplementsCollection(t *testing.T) {
 		t.Errorf("expected length 3, got %d", m.Length())
 	}
 }
+
+func TestCast(t *testing.T) {
+	var c Collection[int] = NewMockCollection([]int{1, 2})
+	if got, err := Cast[*MockCollection[int]]("Filter", c); got != c || err != nil {
+		t.Errorf("Cast() = %v, %v, want %v, %v", got, err, c, nil)
+	}
+
+	_, err := Cast[*MockOrderedCollection[int]]("Filter", c)
+	want := &TypeAssertionError{
+		Op:       "Filter",
+		Expected: "*collection.MockOrderedCollection[int]",
+		Actual:   "*collection.MockCollection[int]",
+	}
+	if e, ok := err.(*TypeAssertionError); !ok || *e != *want {
+		t.Errorf("Cast() error = %v, want %v", err, want)
+	}
+
+	defer func() {
+		if r, ok := recover().(*TypeAssertionError); !ok || r.Op != "Take" {
+			t.Errorf("MustCast() panic = %v, want a TypeAssertionError for Take", r)
+		}
+	}()
+	MustCast[*MockOrderedCollection[int]]("Take", c)
+}
@@ -0,0 +1,244 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// concurrent.go defines package functions that process the elements of a collection concurrently.
+
+package collection
+
+import (
+	"context"
+	"iter"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/charbz/gophers/internal/fanin"
+)
+
+// Outcome is the result of a fallible operation on a single element,
+// holding either a value or an error.
+type Outcome[K any] struct {
+	Value K
+	Err   error
+}
+
+// MapWithTimeout applies the function to every element of the collection concurrently,
+// giving each call its own deadline derived from ctx and the per-item timeout, which suits
+// fan-out RPC aggregation. It waits for every element to complete or time out, and returns
+// the outcomes in iteration order: an element whose call fails or exceeds its deadline carries
+// the error instead of a value, without affecting the other elements.
+//
+// The function should return promptly once its context is done. If it does not, its outcome
+// is set to the context error when the deadline passes and its eventual result is discarded.
+// A non-positive timeout applies no per-item deadline, only the cancellation of ctx.
+// One goroutine is started per element.
+//
+// example usage:
+//
+//	c := NewSequence([]string{"eu-1","us-1","ap-1"})
+//	MapWithTimeout(ctx, c, 200*time.Millisecond, func(ctx context.Context, region string) (int, error) {
+//	  return client.Count(ctx, region)
+//	})
+//
+// possible output:
+//
+//	[{42 <nil>} {0 context deadline exceeded} {17 <nil>}]
+func MapWithTimeout[T, K any](
+	ctx context.Context,
+	s Collection[T],
+	timeout time.Duration,
+	f func(context.Context, T) (K, error),
+) []Outcome[K] {
+	outcomes := make([]Outcome[K], s.Length())
+	var wg sync.WaitGroup
+	i := 0
+	for v := range s.Values() {
+		wg.Add(1)
+		go func(i int, v T) {
+			defer wg.Done()
+			outcomes[i] = callWithTimeout(ctx, timeout, v, f)
+		}(i, v)
+		i++
+	}
+	wg.Wait()
+	return outcomes
+}
+
+// callWithTimeout calls f with a context bounded by the timeout,
+// returning the context error if f does not complete in time.
+func callWithTimeout[T, K any](
+	ctx context.Context,
+	timeout time.Duration,
+	v T,
+	f func(context.Context, T) (K, error),
+) Outcome[K] {
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+	done := make(chan Outcome[K], 1)
+	go func() {
+		value, err := f(ctx, v)
+		done <- Outcome[K]{Value: value, Err: err}
+	}()
+	select {
+	case o := <-done:
+		return o
+	case <-ctx.Done():
+		return Outcome[K]{Err: ctx.Err()}
+	}
+}
+
+// Failure is an element for which an operation failed,
+// with the error returned by the last attempt.
+type Failure[T any] struct {
+	Value    T
+	Err      error
+	Attempts int
+}
+
+// MapRetry applies the function to every element of the collection in order, retrying
+// an element up to the given number of attempts while the function returns an error.
+// Before each retry it waits for the duration returned by backoff for the number of
+// attempts made so far, or not at all if backoff is nil. It returns the results of the
+// successful elements and the failed elements with their last error, both in iteration order.
+//
+// If ctx is cancelled, the current element and all remaining elements fail with the
+// context error. MapRetry panics with an InvalidArgumentError if attempts is less than 1.
+//
+// example usage:
+//
+//	c := NewSequence([]string{"a.json","b.json"})
+//	pages, failed := MapRetry(ctx, c, fetch, 3, ExponentialBackoff(100*time.Millisecond, time.Second))
+//	failed
+//
+// possible output:
+//
+//	[{b.json 503 Service Unavailable 3}]
+func MapRetry[T, K any](
+	ctx context.Context,
+	s Collection[T],
+	f func(context.Context, T) (K, error),
+	attempts int,
+	backoff func(attempt int) time.Duration,
+) ([]K, []Failure[T]) {
+	if attempts < 1 {
+		panic(InvalidArgumentError)
+	}
+	var results []K
+	var failures []Failure[T]
+	for v := range s.Values() {
+		result, n, err := retry(ctx, v, f, attempts, backoff)
+		if err != nil {
+			failures = append(failures, Failure[T]{Value: v, Err: err, Attempts: n})
+		} else {
+			results = append(results, result)
+		}
+	}
+	return results, failures
+}
+
+// retry calls the function until it succeeds, the attempts are exhausted or ctx is done,
+// and returns the last result, the number of attempts made and the last error.
+func retry[T, K any](
+	ctx context.Context,
+	v T,
+	f func(context.Context, T) (K, error),
+	attempts int,
+	backoff func(attempt int) time.Duration,
+) (K, int, error) {
+	var zero K
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return zero, attempt - 1, err
+		}
+		result, err := f(ctx, v)
+		if err == nil || attempt == attempts {
+			return result, attempt, err
+		}
+		if backoff != nil {
+			if err := sleep(ctx, backoff(attempt)); err != nil {
+				return zero, attempt, err
+			}
+		}
+	}
+}
+
+// ExponentialBackoff returns a backoff function for MapRetry waiting base after
+// the first attempt, then doubling the wait after every attempt up to limit.
+func ExponentialBackoff(base, limit time.Duration) func(attempt int) time.Duration {
+	return func(attempt int) time.Duration {
+		d := base
+		for i := 1; i < attempt && d < limit; i++ {
+			d *= 2
+		}
+		return min(d, limit)
+	}
+}
+
+// sleep waits for the duration or until ctx is done, returning the context error.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// FromChannels returns an iterator over the values received from all the channels,
+// fanning them in as they arrive, until every channel is closed or ctx is done. Values
+// from the same channel are yielded in the order they were sent, while values from
+// different channels are interleaved in arrival order. One goroutine is started per
+// channel for each iteration, and stopped when the iteration ends.
+//
+// example usage:
+//
+//	for v := range FromChannels(ctx, results1, results2) {
+//	  fmt.Println(v)
+//	}
+func FromChannels[T any](ctx context.Context, chs ...<-chan T) iter.Seq[T] {
+	return fanin.Merge(ctx, nil, chs...)
+}
+
+// GenerateParallel returns a slice of n elements where the i-th element is f(i), calling f
+// from the given number of goroutines, each generating a contiguous range of indices. It is
+// intended for expensive generators, such as building large data sets for simulations or load
+// tests. A non-positive number of workers uses runtime.GOMAXPROCS(0) goroutines. f must be
+// safe for concurrent use. GenerateParallel panics with an InvalidArgumentError if n is negative.
+//
+// example usage:
+//
+//	GenerateParallel(5, 2, func(i int) int { return i * i })
+//
+// output:
+//
+//	[0 1 4 9 16]
+func GenerateParallel[T any](n, workers int, f func(int) T) []T {
+	if n < 0 {
+		panic(InvalidArgumentError)
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, n)
+	result := make([]T, n)
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func(lo, hi int) {
+			defer wg.Done()
+			for i := lo; i < hi; i++ {
+				result[i] = f(i)
+			}
+		}(w*n/workers, (w+1)*n/workers)
+	}
+	wg.Wait()
+	return result
+}
@@ -0,0 +1,200 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMapWithTimeout(t *testing.T) {
+	errOdd := errors.New("odd")
+	block := make(chan struct{})
+	defer close(block)
+
+	c := NewMockCollection([]int{0, 1, 2, 3, 4})
+	outcomes := MapWithTimeout(context.Background(), c, 50*time.Millisecond, func(ctx context.Context, i int) (int, error) {
+		switch i {
+		case 1:
+			return 0, errOdd
+		case 2:
+			// honors its context
+			<-ctx.Done()
+			return 0, ctx.Err()
+		case 3:
+			// ignores its context
+			<-block
+			return 3, nil
+		}
+		return i * 10, nil
+	})
+
+	want := []Outcome[int]{
+		{Value: 0},
+		{Err: errOdd},
+		{Err: context.DeadlineExceeded},
+		{Err: context.DeadlineExceeded},
+		{Value: 40},
+	}
+	if len(outcomes) != len(want) {
+		t.Fatalf("MapWithTimeout() returned %v outcomes, want %v", len(outcomes), len(want))
+	}
+	for i := range want {
+		if outcomes[i].Value != want[i].Value || !errors.Is(outcomes[i].Err, want[i].Err) {
+			t.Errorf("MapWithTimeout()[%v] = %v, want %v", i, outcomes[i], want[i])
+		}
+	}
+}
+
+func TestMapWithTimeout_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	outcomes := MapWithTimeout(ctx, NewMockCollection([]int{1, 2}), 0, func(ctx context.Context, i int) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	for i, o := range outcomes {
+		if !errors.Is(o.Err, context.Canceled) {
+			t.Errorf("MapWithTimeout()[%v] error = %v, want %v", i, o.Err, context.Canceled)
+		}
+	}
+	if got := MapWithTimeout(ctx, NewMockCollection([]int{}), time.Second, func(context.Context, int) (int, error) {
+		return 0, nil
+	}); len(got) != 0 {
+		t.Errorf("MapWithTimeout() = %v, want %v", got, []Outcome[int]{})
+	}
+}
+
+func TestMapRetry(t *testing.T) {
+	errFlaky := errors.New("flaky")
+	calls := make(map[int]int)
+	var waits []time.Duration
+	c := NewMockCollection([]int{1, 2, 3, 4})
+	results, failures := MapRetry(context.Background(), c, func(_ context.Context, i int) (int, error) {
+		calls[i]++
+		// element i succeeds on attempt i, element 4 never does.
+		if calls[i] < i || i == 4 {
+			return 0, errFlaky
+		}
+		return i * 10, nil
+	}, 3, func(attempt int) time.Duration {
+		waits = append(waits, time.Duration(attempt))
+		return 0
+	})
+	if !slices.Equal(results, []int{10, 20, 30}) {
+		t.Errorf("MapRetry() results = %v, want %v", results, []int{10, 20, 30})
+	}
+	want := []Failure[int]{{Value: 4, Err: errFlaky, Attempts: 3}}
+	if !slices.Equal(failures, want) {
+		t.Errorf("MapRetry() failures = %v, want %v", failures, want)
+	}
+	if want := []time.Duration{1, 1, 2, 1, 2}; !slices.Equal(waits, want) {
+		t.Errorf("MapRetry() backoff attempts = %v, want %v", waits, want)
+	}
+}
+
+func TestMapRetry_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := NewMockCollection([]int{1, 2})
+	results, failures := MapRetry(ctx, c, func(context.Context, int) (int, error) {
+		cancel()
+		return 0, errors.New("unavailable")
+	}, 5, func(int) time.Duration { return time.Hour })
+	want := []Failure[int]{{Value: 1, Err: context.Canceled, Attempts: 1}, {Value: 2, Err: context.Canceled}}
+	if len(results) != 0 || !slices.Equal(failures, want) {
+		t.Errorf("MapRetry() = %v, %v, want %v, %v", results, failures, []int{}, want)
+	}
+
+	defer func() {
+		if r := recover(); r != InvalidArgumentError {
+			t.Errorf("MapRetry() panic = %v, want %v", r, InvalidArgumentError)
+		}
+	}()
+	MapRetry(context.Background(), c, func(context.Context, int) (int, error) { return 0, nil }, 0, nil)
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := ExponentialBackoff(10*time.Millisecond, 50*time.Millisecond)
+	want := []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 40 * time.Millisecond, 50 * time.Millisecond, 50 * time.Millisecond}
+	for i, w := range want {
+		if got := backoff(i + 1); got != w {
+			t.Errorf("ExponentialBackoff()(%v) = %v, want %v", i+1, got, w)
+		}
+	}
+}
+
+func TestFromChannels(t *testing.T) {
+	producer := func(values ...int) <-chan int {
+		ch := make(chan int)
+		go func() {
+			defer close(ch)
+			for _, v := range values {
+				ch <- v
+			}
+		}()
+		return ch
+	}
+	var odd, even []int
+	for v := range FromChannels(context.Background(), producer(1, 3, 5), producer(2, 4), producer()) {
+		if v%2 == 0 {
+			even = append(even, v)
+		} else {
+			odd = append(odd, v)
+		}
+	}
+	if !slices.Equal(odd, []int{1, 3, 5}) || !slices.Equal(even, []int{2, 4}) {
+		t.Errorf("FromChannels() = %v and %v, want %v and %v in order", odd, even, []int{1, 3, 5}, []int{2, 4})
+	}
+
+	// the producers block forever, breaking must not leak them.
+	blocked := make(chan int)
+	for v := range FromChannels(context.Background(), producer(1, 2, 3), blocked) {
+		if v == 2 {
+			break
+		}
+	}
+	if got := slices.Collect(FromChannels[int](context.Background())); len(got) != 0 {
+		t.Errorf("FromChannels() = %v, want empty", got)
+	}
+}
+
+func TestFromChannels_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := make(chan int, 1)
+	ch <- 1
+	var got []int
+	for v := range FromChannels(ctx, ch) {
+		got = append(got, v)
+		cancel()
+	}
+	if !slices.Equal(got, []int{1}) {
+		t.Errorf("FromChannels() = %v, want %v", got, []int{1})
+	}
+}
+
+func TestGenerateParallel(t *testing.T) {
+	for _, workers := range []int{0, 1, 3, 100} {
+		var calls atomic.Int32
+		got := GenerateParallel(10, workers, func(i int) int {
+			calls.Add(1)
+			return i * i
+		})
+		want := []int{0, 1, 4, 9, 16, 25, 36, 49, 64, 81}
+		if !slices.Equal(got, want) || calls.Load() != 10 {
+			t.Errorf("GenerateParallel(%v) = %v after %v calls, want %v", workers, got, calls.Load(), want)
+		}
+	}
+	if got := GenerateParallel(0, 4, func(i int) int { return i }); len(got) != 0 {
+		t.Errorf("GenerateParallel() = %v, want %v", got, []int{})
+	}
+
+	defer func() {
+		if r := recover(); r != InvalidArgumentError {
+			t.Errorf("GenerateParallel() panic = %v, want %v", r, InvalidArgumentError)
+		}
+	}()
+	GenerateParallel(-1, 1, func(i int) int { return i })
+}
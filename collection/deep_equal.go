@@ -0,0 +1,186 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// deep_equal.go defines deep equality of collections whose elements are not comparable
+// with ==, such as slices, maps and structs holding them, or where == on pointers does
+// not express the intended equality.
+
+package collection
+
+import (
+	"iter"
+	"reflect"
+	"unsafe"
+)
+
+// DeepComparer overrides deep equality for the values of a single type.
+// Create one with CompareAs.
+type DeepComparer struct {
+	typ   reflect.Type
+	equal func(a, b any) bool
+}
+
+// CompareAs returns a comparer that EqualsDeep uses instead of deep equality for every
+// value of type V, whether it is an element, a struct field, or nested in a slice, a map
+// or a pointer. Unexported fields cannot be passed to a comparer and are always compared
+// deeply.
+//
+// example usage:
+//
+//	CompareAs(func(a, b time.Time) bool { return a.Equal(b) })
+func CompareAs[V any](f func(a, b V) bool) DeepComparer {
+	return DeepComparer{
+		typ: reflect.TypeFor[V](),
+		equal: func(a, b any) bool {
+			// a nil interface value holds no V, it is passed as the zero value of V.
+			x, _ := a.(V)
+			y, _ := b.(V)
+			return f(x, y)
+		},
+	}
+}
+
+// EqualsDeep reports whether the two ordered collections have the same length and deeply
+// equal elements at every index, as defined by reflect.DeepEqual. The comparers override
+// deep equality for the values of specific types at any depth, such as time.Time values
+// which should be compared with their Equal method, or fields that should be ignored.
+//
+// example usage:
+//
+//	c1 := NewSequence([][]int{{1,2},{3}})
+//	c2 := NewSequence([][]int{{1,2},{3}})
+//	EqualsDeep(c1, c2)
+//
+// output:
+//
+//	true
+func EqualsDeep[T any](s1, s2 OrderedCollection[T], comparers ...DeepComparer) bool {
+	if s1.Length() != s2.Length() {
+		return false
+	}
+	equal := func(a, b T) bool { return reflect.DeepEqual(a, b) }
+	if len(comparers) > 0 {
+		d := newDeepEqual(comparers)
+		equal = func(a, b T) bool {
+			clear(d.visited)
+			// compare addressable copies so that a comparer
+			// registered for an interface type T applies.
+			return d.values(reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem())
+		}
+	}
+	next, stop := iter.Pull(s2.Values())
+	defer stop()
+	for v1 := range s1.Values() {
+		v2, ok := next()
+		if !ok || !equal(v1, v2) {
+			return false
+		}
+	}
+	return true
+}
+
+// deepEqual compares values deeply, applying the comparers
+// registered for their types.
+type deepEqual struct {
+	comparers map[reflect.Type]func(a, b any) bool
+	visited   map[visit]bool
+}
+
+// visit records a pair of references being compared, which stops
+// the comparison of cyclic values from recursing forever.
+type visit struct {
+	a, b unsafe.Pointer
+	typ  reflect.Type
+}
+
+func newDeepEqual(comparers []DeepComparer) *deepEqual {
+	d := &deepEqual{
+		comparers: make(map[reflect.Type]func(a, b any) bool, len(comparers)),
+		visited:   make(map[visit]bool),
+	}
+	for _, c := range comparers {
+		d.comparers[c.typ] = c.equal
+	}
+	return d
+}
+
+// values mirrors reflect.DeepEqual, except that it checks for
+// a comparer before comparing values of any type.
+func (d *deepEqual) values(a, b reflect.Value) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+	if f, ok := d.comparers[a.Type()]; ok && a.CanInterface() {
+		return f(a.Interface(), b.Interface())
+	}
+	switch a.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Pointer:
+		if a.IsNil() != b.IsNil() {
+			return false
+		}
+		if a.UnsafePointer() == b.UnsafePointer() && (a.Kind() != reflect.Slice || a.Len() == b.Len()) {
+			return true
+		}
+		v := visit{a.UnsafePointer(), b.UnsafePointer(), a.Type()}
+		if d.visited[v] {
+			return true
+		}
+		d.visited[v] = true
+	}
+	switch a.Kind() {
+	case reflect.Array, reflect.Slice:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := range a.Len() {
+			if !d.values(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for it := a.MapRange(); it.Next(); {
+			bv := b.MapIndex(it.Key())
+			if !bv.IsValid() || !d.values(it.Value(), bv) {
+				return false
+			}
+		}
+		return true
+	case reflect.Pointer, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return d.values(a.Elem(), b.Elem())
+	case reflect.Struct:
+		for i := range a.NumField() {
+			if !d.values(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Func:
+		return a.IsNil() && b.IsNil()
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() == b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() == b.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return a.Complex() == b.Complex()
+	case reflect.String:
+		return a.String() == b.String()
+	default:
+		// channels and unsafe pointers are equal if they are the same reference.
+		return a.Pointer() == b.Pointer()
+	}
+}
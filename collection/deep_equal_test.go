@@ -0,0 +1,89 @@
+package collection
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEqualsDeep(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b [][]int
+		want bool
+	}{
+		{name: "equal", a: [][]int{{1, 2}, {3}}, b: [][]int{{1, 2}, {3}}, want: true},
+		{name: "different element", a: [][]int{{1, 2}, {3}}, b: [][]int{{1, 2}, {4}}, want: false},
+		{name: "different length", a: [][]int{{1}}, b: [][]int{{1}, {2}}, want: false},
+		{name: "nil and empty slices differ", a: [][]int{nil}, b: [][]int{{}}, want: false},
+		{name: "empty", a: [][]int{}, b: [][]int{}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EqualsDeep[[]int](NewMockOrderedCollection(tt.a), NewMockOrderedCollection(tt.b))
+			if got != tt.want {
+				t.Errorf("EqualsDeep() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEqualsDeep_Comparers(t *testing.T) {
+	type event struct {
+		Name string
+		At   time.Time
+		Tags map[string][]string
+		Next *event
+	}
+	now := time.Now()
+	utc := now.UTC()
+	a := []event{{Name: "a", At: now, Tags: map[string][]string{"k": {"v"}}}}
+	b := []event{{Name: "a", At: utc, Tags: map[string][]string{"k": {"v"}}}}
+	a[0].Next, b[0].Next = &a[0], &b[0]
+
+	sameInstant := CompareAs(func(x, y time.Time) bool { return x.Equal(y) })
+	if EqualsDeep[event](NewMockOrderedCollection(a), NewMockOrderedCollection(b)) {
+		t.Errorf("EqualsDeep() = true for times in different locations, want false")
+	}
+	if !EqualsDeep[event](NewMockOrderedCollection(a), NewMockOrderedCollection(b), sameInstant) {
+		t.Errorf("EqualsDeep() = false with a time.Time comparer, want true")
+	}
+	b[0].Tags["k"] = []string{"V"}
+	if EqualsDeep[event](NewMockOrderedCollection(a), NewMockOrderedCollection(b), sameInstant) {
+		t.Errorf("EqualsDeep() = true for different nested values, want false")
+	}
+	foldCase := CompareAs(strings.EqualFold)
+	if !EqualsDeep[event](NewMockOrderedCollection(a), NewMockOrderedCollection(b), sameInstant, foldCase) {
+		t.Errorf("EqualsDeep() = false with a string comparer, want true")
+	}
+}
+
+func TestEqualsDeep_InterfaceComparer(t *testing.T) {
+	a := NewMockOrderedCollection([]any{1, "x"})
+	b := NewMockOrderedCollection([]any{2, "y"})
+	always := CompareAs(func(x, y any) bool { return true })
+	if !EqualsDeep[any](a, b, always) {
+		t.Errorf("EqualsDeep() = false with an interface comparer, want true")
+	}
+	if EqualsDeep[any](a, b) {
+		t.Errorf("EqualsDeep() = true for different values, want false")
+	}
+}
+
+func TestEqualsDeep_NilInterfaceComparer(t *testing.T) {
+	type result struct {
+		Err error
+	}
+	errBoom := errors.New("boom")
+	a := NewMockOrderedCollection([]result{{Err: nil}, {Err: errBoom}})
+	b := NewMockOrderedCollection([]result{{Err: nil}, {Err: errBoom}})
+	is := CompareAs(func(x, y error) bool { return errors.Is(x, y) })
+	if !EqualsDeep(a, b, is) {
+		t.Errorf("EqualsDeep() = false with nil errors, want true")
+	}
+	c := NewMockOrderedCollection([]result{{Err: errBoom}, {Err: errBoom}})
+	if EqualsDeep(a, c, is) {
+		t.Errorf("EqualsDeep() = true for a nil and a non-nil error, want false")
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// fingerprint.go defines functions that hash the contents of collections.
+
+package collection
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"reflect"
+)
+
+// Fingerprint returns a 64-bit hash of the elements of an ordered collection, which allows
+// collections to be used as map or cache keys, or deduplicated. Collections holding equal
+// elements in the same order have the same fingerprint, the fingerprint changes with the order.
+// Elements are hashed from their default format, with -0.0 hashed as 0.0 since they are
+// equal, so the fingerprint is stable across runs for values such as numbers, strings and
+// structs of them, but not for pointers, channels or structs holding them, whose format
+// is a memory address. As with any hash, different collections may collide.
+//
+// example usage:
+//
+//	c1 := NewSequence([]int{1,2,3})
+//	c2 := NewSequence([]int{3,2,1})
+//	Fingerprint(c1) == Fingerprint(c2)
+//
+// output:
+//
+//	false
+func Fingerprint[T comparable](s OrderedCollection[T]) uint64 {
+	h, acc := fnv.New64a(), fnv.New64a()
+	var buf [8]byte
+	for v := range s.Values() {
+		binary.LittleEndian.PutUint64(buf[:], hashValue(h, v))
+		acc.Write(buf[:])
+	}
+	return acc.Sum64()
+}
+
+// FingerprintUnordered is similar to Fingerprint but ignores the order of the elements,
+// collections holding the same elements in any order have the same fingerprint.
+// It is meant for unordered collections such as Sets.
+//
+// example usage:
+//
+//	s1 := NewSet([]string{"a","b"})
+//	s2 := NewSet([]string{"b","a"})
+//	FingerprintUnordered(s1) == FingerprintUnordered(s2)
+//
+// output:
+//
+//	true
+func FingerprintUnordered[T comparable](s Collection[T]) uint64 {
+	h := fnv.New64a()
+	var sum uint64
+	for v := range s.Values() {
+		sum += mix64(hashValue(h, v))
+	}
+	return mix64(sum ^ uint64(s.Length()))
+}
+
+// hashValue returns the hash of the default format of v, resetting h before use.
+func hashValue(h hash.Hash64, v any) uint64 {
+	h.Reset()
+	fmt.Fprint(h, normalizeZero(v))
+	return h.Sum64()
+}
+
+// appendKey appends the bytes hashed for the key k to buf, the bytes of strings
+// and integers, and the default format of other keys.
+func appendKey[K comparable](buf []byte, k K) []byte {
+	switch k := any(k).(type) {
+	case string:
+		return append(buf, k...)
+	case int:
+		return binary.LittleEndian.AppendUint64(buf, uint64(k))
+	case int64:
+		return binary.LittleEndian.AppendUint64(buf, uint64(k))
+	case uint64:
+		return binary.LittleEndian.AppendUint64(buf, k)
+	case int32:
+		return binary.LittleEndian.AppendUint32(buf, uint32(k))
+	case uint32:
+		return binary.LittleEndian.AppendUint32(buf, k)
+	}
+	return fmt.Append(buf, normalizeZero(any(k)))
+}
+
+// normalizeZero returns positive zero if v is a negative zero float, which
+// it is equal to but formatted differently from, and v otherwise.
+func normalizeZero(v any) any {
+	if r := reflect.ValueOf(v); (r.Kind() == reflect.Float32 || r.Kind() == reflect.Float64) && r.Float() == 0 {
+		return reflect.Zero(r.Type()).Interface()
+	}
+	return v
+}
+
+// mix64 scrambles the bits of x (the splitmix64 finalizer), so that summing
+// mixed hashes does not cancel out structure in the original hashes.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
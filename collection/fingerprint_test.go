@@ -0,0 +1,64 @@
+package collection
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFingerprint(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     []string
+		b     []string
+		equal bool
+	}{
+		{name: "same elements", a: []string{"a", "b"}, b: []string{"a", "b"}, equal: true},
+		{name: "different order", a: []string{"a", "b"}, b: []string{"b", "a"}, equal: false},
+		{name: "different elements", a: []string{"a", "b"}, b: []string{"a", "c"}, equal: false},
+		{name: "concatenation boundaries", a: []string{"ab", "c"}, b: []string{"a", "bc"}, equal: false},
+		{name: "prefix", a: []string{"a"}, b: []string{"a", "a"}, equal: false},
+		{name: "both empty", a: []string{}, b: []string{}, equal: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := NewMockOrderedCollection(tt.a), NewMockOrderedCollection(tt.b)
+			if got := Fingerprint(a) == Fingerprint(b); got != tt.equal {
+				t.Errorf("Fingerprint(%v) == Fingerprint(%v) = %v, want %v", tt.a, tt.b, got, tt.equal)
+			}
+		})
+	}
+}
+
+func TestFingerprintUnordered(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     []int
+		b     []int
+		equal bool
+	}{
+		{name: "same order", a: []int{1, 2, 3}, b: []int{1, 2, 3}, equal: true},
+		{name: "different order", a: []int{1, 2, 3}, b: []int{3, 1, 2}, equal: true},
+		{name: "different elements", a: []int{1, 2, 3}, b: []int{1, 2, 4}, equal: false},
+		{name: "subset", a: []int{1, 2}, b: []int{1, 2, 3}, equal: false},
+		{name: "empty and zero", a: []int{}, b: []int{0}, equal: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := NewMockCollection(tt.a), NewMockCollection(tt.b)
+			if got := FingerprintUnordered(a) == FingerprintUnordered(b); got != tt.equal {
+				t.Errorf("FingerprintUnordered(%v) == FingerprintUnordered(%v) = %v, want %v", tt.a, tt.b, got, tt.equal)
+			}
+		})
+	}
+}
+
+func TestFingerprint_SignedZero(t *testing.T) {
+	a := NewMockOrderedCollection([]float64{math.Copysign(0, -1), 1})
+	b := NewMockOrderedCollection([]float64{0, 1})
+	if Fingerprint(a) != Fingerprint(b) {
+		t.Errorf("Fingerprint(%v) != Fingerprint(%v), want equal fingerprints", a.items, b.items)
+	}
+	if FingerprintUnordered(a) != FingerprintUnordered(b) {
+		t.Errorf("FingerprintUnordered(%v) != FingerprintUnordered(%v), want equal fingerprints", a.items, b.items)
+	}
+}
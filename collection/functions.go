@@ -14,8 +14,116 @@ package collection
 
 import (
 	"cmp"
+	"hash/fnv"
+	"slices"
+	"strings"
 )
 
+// All is an alias for ForAll, it tests whether the predicate holds for every element
+// of the collection, stopping at the first element that does not satisfy it.
+// It returns true for an empty collection.
+//
+// example usage:
+//
+//	c := NewSequence([]int{2,4,6})
+//	All(c, func(i int) bool { return i%2 == 0 })
+//
+// output:
+//
+//	true
+func All[T any](s Collection[T], f func(T) bool) bool {
+	return ForAll(s, f)
+}
+
+// AllTrue returns true if every element of a collection of booleans is true.
+// It returns true for an empty collection.
+//
+// example usage:
+//
+//	c := NewSequence([]bool{true,false,true})
+//	AllTrue(c)
+//
+// output:
+//
+//	false
+func AllTrue(s Collection[bool]) bool {
+	return ForAll(s, func(b bool) bool { return b })
+}
+
+// Any tests whether the predicate holds for at least one element of the collection,
+// stopping at the first element that satisfies it.
+// It returns false for an empty collection.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,3,4})
+//	Any(c, func(i int) bool { return i%2 == 0 })
+//
+// output:
+//
+//	true
+func Any[T any](s Collection[T], f func(T) bool) bool {
+	for v := range s.Values() {
+		if f(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// AnyTrue returns true if at least one element of a collection of booleans is true.
+// It returns false for an empty collection.
+//
+// example usage:
+//
+//	c := NewSequence([]bool{false,true,false})
+//	AnyTrue(c)
+//
+// output:
+//
+//	true
+func AnyTrue(s Collection[bool]) bool {
+	return Any(s, func(b bool) bool { return b })
+}
+
+// AppendToSlice appends the elements of the collection to dst and returns the extended slice,
+// allowing callers to reuse a pre-allocated buffer instead of allocating a new slice.
+//
+// example usage:
+//
+//	c := NewSequence([]int{3,4})
+//	buf := make([]int, 0, 8)
+//	buf = AppendToSlice(c, append(buf, 1, 2))
+//
+// output:
+//
+//	[1,2,3,4]
+func AppendToSlice[T any](s Collection[T], dst []T) []T {
+	for v := range s.Values() {
+		dst = append(dst, v)
+	}
+	return dst
+}
+
+// Choice returns a random element of the collection. Unlike the Random method, whose
+// behavior on an empty collection differs between collection types, it consistently
+// returns an EmptyCollectionError if the collection is empty.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,3})
+//	Choice(c)
+//
+// possible output:
+//
+//	2, nil
+func Choice[T any](s Collection[T]) (T, error) {
+	if s.Length() == 0 {
+		return *new(T), EmptyCollectionError
+	}
+	return s.Random(), nil
+}
+
 // Count returns the number of elements in the collection that satisfy the predicate function.
 //
 // example usage:
@@ -36,6 +144,26 @@ func Count[T any](s Collection[T], f func(T) bool) int {
 	return count
 }
 
+// CountValue returns the number of elements in the collection equal to v.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,2,3,2})
+//	CountValue(c, 2)
+//
+// output:
+//
+//	3
+func CountValue[T comparable](s Collection[T], v T) int {
+	count := 0
+	for e := range s.Values() {
+		if e == v {
+			count++
+		}
+	}
+	return count
+}
+
 // Diff returns a new collection containing elements that are present in the first collection but not in the second.
 //
 // example usage:
@@ -58,6 +186,28 @@ func Diff[T comparable](s1 Collection[T], s2 Collection[T]) Collection[T] {
 	})
 }
 
+// DiffBy returns a new collection containing the elements of the first collection
+// whose key is not the key of any element of the second collection. Keys are
+// produced by the key function and looked up in a map, which makes DiffBy
+// linear in the size of both collections.
+//
+// example usage:
+//
+//	c1 := NewSequence([]User{{ID: 1}, {ID: 2}, {ID: 3}})
+//	c2 := NewSequence([]User{{ID: 2}})
+//	DiffBy(c1, c2, func(u User) int { return u.ID })
+//
+// output:
+//
+//	[{ID: 1}, {ID: 3}]
+func DiffBy[T any, K comparable](s1 Collection[T], s2 Collection[T], f func(T) K) Collection[T] {
+	keys := keySet(s2, f)
+	return FilterNot(s1, func(t T) bool {
+		_, ok := keys[f(t)]
+		return ok
+	})
+}
+
 // DiffFunc is similar to Diff but applies to non-comparable types.
 // It takes two collections (s1, s2) and an "equality" function as an argument such as
 // func(a T, b T) bool {return a == b}
@@ -110,6 +260,60 @@ func Distinct[T any](s Collection[T], f func(T, T) bool) Collection[T] {
 	return s2
 }
 
+// DistinctByKeepLast returns a new collection containing, for each key produced by the
+// key function, only the last element with that key. The surviving elements keep their
+// relative order, which suits streams of records where later records are authoritative
+// updates of earlier ones.
+//
+// example usage:
+//
+//	c := NewSequence([]User{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}, {ID: 1, Name: "c"}})
+//	DistinctByKeepLast(c, func(u User) int { return u.ID })
+//
+// output:
+//
+//	[{ID: 2, Name: "b"}, {ID: 1, Name: "c"}]
+func DistinctByKeepLast[T any, K comparable](s Collection[T], f func(T) K) Collection[T] {
+	// materialize the elements in a single pass, since unordered collections
+	// may iterate in a different order every time.
+	// an element is dropped when a later element has the same key, so keys that
+	// are not equal to themselves, such as NaN, are always kept.
+	values := make([]T, 0, s.Length())
+	keep := make([]bool, 0, s.Length())
+	last := make(map[K]int)
+	for v := range s.Values() {
+		k := f(v)
+		if i, ok := last[k]; ok {
+			keep[i] = false
+		}
+		last[k] = len(values)
+		values = append(values, v)
+		keep = append(keep, true)
+	}
+	s2 := s.New()
+	for i, v := range values {
+		if keep[i] {
+			s2.Add(v)
+		}
+	}
+	return s2
+}
+
+// DistinctKeepLast returns a new collection containing only the last occurrence
+// of each element, preserving the relative order of the surviving elements.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,1,3,2})
+//	DistinctKeepLast(c)
+//
+// output:
+//
+//	[1,3,2]
+func DistinctKeepLast[T comparable](s Collection[T]) Collection[T] {
+	return DistinctByKeepLast(s, func(v T) T { return v })
+}
+
 // Filter returns a new collection containing only the elements that
 // satisfy the predicate function.
 //
@@ -145,6 +349,27 @@ func FilterNot[T any](s Collection[T], f func(T) bool) Collection[T] {
 	return Filter(s, func(t T) bool { return !f(t) })
 }
 
+// FindOr returns the first element of the collection that satisfies
+// the predicate, or the default value if no element does.
+// For unordered collections, the first element found is not deterministic.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,3,4,5,6})
+//	FindOr(c, func(i int) bool { return i > 10 }, -1)
+//
+// output:
+//
+//	-1
+func FindOr[T any](s Collection[T], f func(T) bool, def T) T {
+	for v := range s.Values() {
+		if f(v) {
+			return v
+		}
+	}
+	return def
+}
+
 // ForAll tests whether a predicate holds for all elements of this sequence.
 //
 // example usage:
@@ -164,6 +389,54 @@ func ForAll[T any](s Collection[T], f func(T) bool) bool {
 	return true
 }
 
+// ForEachWhile calls f with each element of the collection until f returns false,
+// and reports whether every element was visited. It behaves like ForAll, but is
+// intended for side-effecting loops that need an early exit.
+//
+// example usage:
+//
+//	c := NewSequence([]string{"a","b","stop","c"})
+//	ForEachWhile(c, func(s string) bool {
+//		if s == "stop" {
+//			return false
+//		}
+//		fmt.Println(s)
+//		return true
+//	})
+//
+// output:
+//
+//	a
+//	b
+//	false
+func ForEachWhile[T any](s Collection[T], f func(T) bool) bool {
+	for v := range s.Values() {
+		if !f(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Frequencies returns a map from each distinct element of the collection
+// to the number of times it occurs.
+//
+// example usage:
+//
+//	c := NewSequence([]string{"a","b","a","c","a","b"})
+//	Frequencies(c)
+//
+// output:
+//
+//	map[a:3 b:2 c:1]
+func Frequencies[T comparable](s Collection[T]) map[T]int {
+	m := make(map[T]int)
+	for v := range s.Values() {
+		m[v]++
+	}
+	return m
+}
+
 // GroupBy takes a collection and a grouping function as input and returns a map
 // where the key is the result of the grouping function and the value is a collection
 // of elements that satisfy the predicate.
@@ -188,6 +461,29 @@ func GroupBy[T any, K comparable](s Collection[T], f func(T) K) map[K]Collection
 	return m
 }
 
+// InspectEach calls the function with the index and value of each element
+// of the collection, then returns the collection unchanged.
+// It is useful to log or debug elements in the middle of a chain of operations.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,3})
+//	InspectEach(c, func(i int, v int) { fmt.Println(i, v) })
+//
+// output:
+//
+//	0 1
+//	1 2
+//	2 3
+func InspectEach[T any](s Collection[T], f func(int, T)) Collection[T] {
+	i := 0
+	for v := range s.Values() {
+		f(i, v)
+		i++
+	}
+	return s
+}
+
 // Intersect returns a new collection containing elements that are present in both input collections.
 //
 // example usage:
@@ -210,6 +506,28 @@ func Intersect[T comparable](s1 Collection[T], s2 Collection[T]) Collection[T] {
 	})
 }
 
+// IntersectBy returns a new collection containing the elements of the first collection
+// whose key is also the key of an element of the second collection. Keys are
+// produced by the key function and looked up in a map, which makes IntersectBy
+// linear in the size of both collections.
+//
+// example usage:
+//
+//	c1 := NewSequence([]User{{ID: 1}, {ID: 2}, {ID: 3}})
+//	c2 := NewSequence([]User{{ID: 2}, {ID: 4}})
+//	IntersectBy(c1, c2, func(u User) int { return u.ID })
+//
+// output:
+//
+//	[{ID: 2}]
+func IntersectBy[T any, K comparable](s1 Collection[T], s2 Collection[T], f func(T) K) Collection[T] {
+	keys := keySet(s2, f)
+	return Filter(s1, func(t T) bool {
+		_, ok := keys[f(t)]
+		return ok
+	})
+}
+
 // IntersectFunc is similar to Intersect but applies to non-comparable types.
 // It takes two collections (s1, s2) and an "equality" function as an argument such as
 // func(a T, b T) bool {return a == b}
@@ -235,6 +553,79 @@ func IntersectFunc[T any](s1 Collection[T], s2 Collection[T], f func(T, T) bool)
 	})
 }
 
+// Join flattens a collection of collections into a single slice, inserting the
+// separator element between consecutive collections. The result is allocated once.
+//
+// example usage:
+//
+//	words := NewSequence([]*Sequence[rune]{
+//		NewSequence([]rune("go")),
+//		NewSequence([]rune("fmt")),
+//	})
+//	Join(words, '.')
+//
+// output:
+//
+//	[g o . f m t]
+func Join[T any, C Collection[T]](s Collection[C], sep T) []T {
+	n := 0
+	for c := range s.Values() {
+		n += c.Length() + 1
+	}
+	result := make([]T, 0, max(n-1, 0))
+	first := true
+	for c := range s.Values() {
+		if !first {
+			result = append(result, sep)
+		}
+		first = false
+		for v := range c.Values() {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// JoinBy maps each element of the collection to a string and joins the results
+// using the separator. It returns an empty string for an empty collection.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,3})
+//	JoinBy(c, strconv.Itoa, ", ")
+//
+// output:
+//
+//	"1, 2, 3"
+func JoinBy[T any](s Collection[T], f func(T) string, sep string) string {
+	return strings.Join(Map(s, f), sep)
+}
+
+// Indexed is an element paired with its position in the collection it came from,
+// which allows positions to survive transformations such as Filter and Map.
+type Indexed[T any] struct {
+	Index int
+	Value T
+}
+
+// KeepIndex lifts a mapping function over values into a mapping function over indexed
+// values, which transforms the value while preserving its original position.
+//
+// example usage:
+//
+//	words := sequence.WithIndex(NewSequence([]string{"go","", "zig"}))
+//	nonEmpty := words.Filter(func(w Indexed[string]) bool { return w.Value != "" })
+//	sequence.MapTo(nonEmpty, KeepIndex(strings.ToUpper))
+//
+// output:
+//
+//	[{0 GO} {2 ZIG}]
+func KeepIndex[T, K any](f func(T) K) func(Indexed[T]) Indexed[K] {
+	return func(v Indexed[T]) Indexed[K] {
+		return Indexed[K]{Index: v.Index, Value: f(v.Value)}
+	}
+}
+
 // Map takes a collection of type T and a mapping function func(T) K,
 // applies the mapping function to each element and returns a slice of type K.
 //
@@ -256,6 +647,71 @@ func Map[T, K any](s Collection[T], f func(T) K) []K {
 	return k
 }
 
+// MapCached is similar to Map but memoizes the mapping function by the key returned by
+// the key function, so that f is called only once per distinct key. This can speed up
+// mapping collections containing many repeated values through an expensive function.
+// The function must return the same result for all elements sharing a key.
+//
+// example usage:
+//
+//	c := NewSequence([]string{"us","fr","us","us","fr"})
+//	MapCached(c, lookupCountryName, func(code string) string { return code })
+//
+// output:
+//
+//	[United States, France, United States, United States, France]
+func MapCached[T, K any, C comparable](s Collection[T], f func(T) K, key func(T) C) []K {
+	return MapCachedWith(s, f, key, nil)
+}
+
+// MapCachedWith is similar to MapCached but memoizes the results in the supplied cache,
+// which can be reused across calls to avoid recomputing values seen previously.
+// The cache is populated with the results of f, a nil cache is only used for this call.
+// The cache is not safe for concurrent use by multiple goroutines.
+//
+// example usage:
+//
+//	cache := make(map[string]string)
+//	MapCachedWith(batch1, lookupCountryName, func(code string) string { return code }, cache)
+//	MapCachedWith(batch2, lookupCountryName, func(code string) string { return code }, cache)
+func MapCachedWith[T, K any, C comparable](s Collection[T], f func(T) K, key func(T) C, cache map[C]K) []K {
+	if cache == nil {
+		cache = make(map[C]K)
+	}
+	k := make([]K, 0, s.Length())
+	for v := range s.Values() {
+		c := key(v)
+		r, ok := cache[c]
+		if !ok {
+			r = f(v)
+			cache[c] = r
+		}
+		k = append(k, r)
+	}
+	return k
+}
+
+// MapInto is similar to Map but adds the mapped values to the destination
+// collection dst, which is then returned. This allows mapping into a
+// concrete collection of a different type.
+//
+// example usage:
+//
+//	names := NewSequence([]string{"Alice", "Bob", "Charlie"})
+//	MapInto(names, func(name string) int {
+//	  return len(name)
+//	}, NewList[int]())
+//
+// output:
+//
+//	List(int) [5,3,7]
+func MapInto[T, K any, C Collection[K]](s Collection[T], f func(T) K, dst C) C {
+	for v := range s.Values() {
+		dst.Add(f(v))
+	}
+	return dst
+}
+
 // MaxBy returns the element in the collection that has the maximum value
 // according to a comparison function.
 //
@@ -308,6 +764,76 @@ func MinBy[T any, K cmp.Ordered](s Collection[T], f func(T) K) (T, error) {
 	return minElement, nil
 }
 
+// Frequency is an element of a collection paired with its number of occurrences.
+type Frequency[T any] struct {
+	Value T
+	Count int
+}
+
+// MostCommon returns the n most frequent elements of the collection with their counts,
+// from the most common to the least common. Elements with the same count are returned
+// in the order they first appear in the collection. If n is negative or greater than
+// the number of distinct elements, all distinct elements are returned.
+//
+// example usage:
+//
+//	c := NewSequence([]string{"a","b","a","c","a","b"})
+//	MostCommon(c, 2)
+//
+// output:
+//
+//	[{a 3} {b 2}]
+func MostCommon[T comparable](s Collection[T], n int) []Frequency[T] {
+	index := make(map[T]int)
+	var freqs []Frequency[T]
+	for v := range s.Values() {
+		if i, ok := index[v]; ok {
+			freqs[i].Count++
+			continue
+		}
+		index[v] = len(freqs)
+		freqs = append(freqs, Frequency[T]{Value: v, Count: 1})
+	}
+	slices.SortStableFunc(freqs, func(a, b Frequency[T]) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+	if n >= 0 && n < len(freqs) {
+		freqs = freqs[:n]
+	}
+	return freqs
+}
+
+// None tests whether the predicate holds for no element of the collection,
+// stopping at the first element that satisfies it.
+// It returns true for an empty collection.
+//
+// example usage:
+//
+//	c := NewSequence([]string{"alice","bob"})
+//	None(c, func(s string) bool { return s == "" })
+//
+// output:
+//
+//	true
+func None[T any](s Collection[T], f func(T) bool) bool {
+	return !Any(s, f)
+}
+
+// NoneTrue returns true if no element of a collection of booleans is true.
+// It returns true for an empty collection.
+//
+// example usage:
+//
+//	c := NewSequence([]bool{false,false})
+//	NoneTrue(c)
+//
+// output:
+//
+//	true
+func NoneTrue(s Collection[bool]) bool {
+	return !AnyTrue(s)
+}
+
 // Partition takes a partitioning function as input and returns two collections,
 // the first one contains the elements that match the partitioning condition,
 // the second one contains the rest of the elements.
@@ -335,6 +861,21 @@ func Partition[T any](s Collection[T], f func(T) bool) (Collection[T], Collectio
 	return match, noMatch
 }
 
+// ProductBy returns the product of the values produced by the function
+// for each element of the collection. It returns 1 for an empty collection.
+//
+// example usage:
+//
+//	c := NewSequence([]Item{{Qty: 2}, {Qty: 3}})
+//	ProductBy(c, func(i Item) int { return i.Qty })
+//
+// output:
+//
+//	6
+func ProductBy[T any, K Number](s Collection[T], f func(T) K) K {
+	return Reduce(s, func(acc K, v T) K { return acc * f(v) }, K(1))
+}
+
 // Reduce takes a collection of type T, a reducing function func(K, T) K,
 // and an initial value of type K as parameters. It applies the reducing
 // function to each element and returns the resulting value K.
@@ -357,3 +898,153 @@ func Reduce[T, K any](s Collection[T], f func(K, T) K, init K) K {
 	}
 	return accumulator
 }
+
+// RequireDistinctBy validates that the key function produces a distinct key for
+// every element of the collection. If it does not, it returns a *DuplicateKeyError
+// listing the duplicate keys and the indices of the elements that produced them.
+//
+// example usage:
+//
+//	c := NewSequence([]string{"apple", "avocado", "banana", "blueberry", "cherry"})
+//	RequireDistinctBy(c, func(s string) byte { return s[0] })
+//
+// output:
+//
+//	duplicate keys: 97 at [0 1], 98 at [2 3]
+func RequireDistinctBy[T any, K comparable](s Collection[T], f func(T) K) error {
+	indices := make(map[K][]int)
+	var keys []K
+	i := 0
+	for v := range s.Values() {
+		k := f(v)
+		indices[k] = append(indices[k], i)
+		if len(indices[k]) == 2 {
+			keys = append(keys, k)
+		}
+		i++
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	err := &DuplicateKeyError[K]{Keys: keys, Indices: make(map[K][]int, len(keys))}
+	for _, k := range keys {
+		err.Indices[k] = indices[k]
+	}
+	return err
+}
+
+// Shard splits the collection into n sub-collections whose lengths differ by at most one,
+// typically to distribute work across n workers. The elements are assigned in iteration
+// order, so shards of an ordered collection are contiguous, while shards of a Set hold
+// arbitrary elements. Each shard is constructed using s.New(), some shards are empty
+// if the collection has fewer than n elements.
+// It panics with an InvalidArgumentError if n is not positive.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,3,4,5,6,7})
+//	Shard(c, 3)
+//
+// output:
+//
+//	[[1,2,3] [4,5] [6,7]]
+func Shard[T any](s Collection[T], n int) []Collection[T] {
+	if n <= 0 {
+		panic(InvalidArgumentError)
+	}
+	shards := make([]Collection[T], n)
+	for i := range shards {
+		shards[i] = s.New()
+	}
+	// the first length%n shards hold one more element than the others
+	size, extra := s.Length()/n, s.Length()%n
+	i, count := 0, 0
+	for v := range s.Values() {
+		limit := size
+		if i < extra {
+			limit++
+		}
+		if count == limit {
+			i, count = i+1, 0
+		}
+		shards[i].Add(v)
+		count++
+	}
+	return shards
+}
+
+// ShardBy splits the collection into n sub-collections by hashing the key returned
+// by f for each element, so that elements with the same key always land in the same
+// shard, for example to process all the events of a user on the same worker. The hash
+// is computed from the bytes of string and integer keys and from the default format of
+// other keys, so the shards are stable across runs, except for keys holding pointers or
+// channels, whose format is a memory address.
+// Each shard is constructed using s.New(), and the order of the elements is preserved
+// within a shard. It panics with an InvalidArgumentError if n is not positive.
+//
+// example usage:
+//
+//	c := NewSequence([]Event{{User: "ann"}, {User: "bob"}, {User: "ann"}})
+//	ShardBy(c, func(e Event) string { return e.User }, 2)
+//
+// possible output:
+//
+//	[[{ann} {ann}] [{bob}]]
+func ShardBy[T any, K comparable](s Collection[T], f func(T) K, n int) []Collection[T] {
+	if n <= 0 {
+		panic(InvalidArgumentError)
+	}
+	shards := make([]Collection[T], n)
+	for i := range shards {
+		shards[i] = s.New()
+	}
+	h := fnv.New64a()
+	var buf []byte
+	for v := range s.Values() {
+		buf = appendKey(buf[:0], f(v))
+		h.Reset()
+		h.Write(buf)
+		shards[h.Sum64()%uint64(n)].Add(v)
+	}
+	return shards
+}
+
+// SumBy returns the sum of the values produced by the function
+// for each element of the collection. It returns 0 for an empty collection.
+//
+// example usage:
+//
+//	c := NewSequence([]Item{{Price: 2}, {Price: 3}})
+//	SumBy(c, func(i Item) int { return i.Price })
+//
+// output:
+//
+//	5
+func SumBy[T any, K Number](s Collection[T], f func(T) K) K {
+	return Reduce(s, func(acc K, v T) K { return acc + f(v) }, K(0))
+}
+
+// Tap calls the function with the collection, then returns the collection unchanged.
+// It is useful to log or debug a collection in the middle of a chain of operations.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,3})
+//	Tap(c, func(c Collection[int]) { fmt.Println(c.Length()) })
+//
+// output:
+//
+//	3
+func Tap[T any](s Collection[T], f func(Collection[T])) Collection[T] {
+	f(s)
+	return s
+}
+
+// keySet returns the set of keys produced by the key function over the collection.
+func keySet[T any, K comparable](s Collection[T], f func(T) K) map[K]struct{} {
+	keys := make(map[K]struct{}, s.Length())
+	for v := range s.Values() {
+		keys[f(v)] = struct{}{}
+	}
+	return keys
+}
@@ -1,10 +1,25 @@
 package collection
 
 import (
+	"iter"
+	"maps"
+	"math"
 	"slices"
 	"testing"
 )
 
+func TestChoice(t *testing.T) {
+	c := NewMockCollection([]int{1, 2, 3})
+	for range 10 {
+		if got, err := Choice(c); got < 1 || got > 3 || err != nil {
+			t.Errorf("Choice() = %v, %v, want an element and %v", got, err, nil)
+		}
+	}
+	if got, err := Choice(NewMockCollection([]int{})); got != 0 || err != EmptyCollectionError {
+		t.Errorf("Choice() = %v, %v, want %v, %v", got, err, 0, EmptyCollectionError)
+	}
+}
+
 func TestCount(t *testing.T) {
 	countEvens := func(n int) bool { return n%2 == 0 }
 	tests := []struct {
@@ -26,6 +41,95 @@ func TestCount(t *testing.T) {
 	}
 }
 
+func TestAppendToSlice(t *testing.T) {
+	buf := make([]int, 1, 8)
+	buf[0] = 9
+	got := AppendToSlice(NewMockCollection([]int{1, 2, 3}), buf)
+	if !slices.Equal(got, []int{9, 1, 2, 3}) {
+		t.Errorf("AppendToSlice() = %v, want %v", got, []int{9, 1, 2, 3})
+	}
+	if &got[0] != &buf[0] {
+		t.Errorf("AppendToSlice() did not reuse the destination buffer")
+	}
+	if got := AppendToSlice(NewMockCollection([]int{}), nil); got != nil {
+		t.Errorf("AppendToSlice() = %v, want %v", got, nil)
+	}
+}
+
+func TestJoin(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts [][]int
+		want  []int
+	}{
+		{name: "three parts", parts: [][]int{{1, 2}, {3}, {4, 5}}, want: []int{1, 2, 0, 3, 0, 4, 5}},
+		{name: "empty parts", parts: [][]int{{}, {1}, {}}, want: []int{0, 1, 0}},
+		{name: "single part", parts: [][]int{{1, 2}}, want: []int{1, 2}},
+		{name: "no parts", parts: [][]int{}, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parts := NewMockCollection([]*MockCollection[int]{})
+			for _, p := range tt.parts {
+				parts.Add(NewMockCollection(p))
+			}
+			got := Join(parts, 0)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Join() = %v, want %v", got, tt.want)
+			}
+			if cap(got) != len(tt.want) {
+				t.Errorf("Join() cap = %v, want %v", cap(got), len(tt.want))
+			}
+		})
+	}
+}
+
+func TestForEachWhile(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []int
+		stopAt    int
+		completed bool
+		visited   []int
+	}{
+		{name: "stops early", input: []int{1, 2, 3, 4}, stopAt: 3, completed: false, visited: []int{1, 2, 3}},
+		{name: "completes", input: []int{1, 2}, stopAt: 5, completed: true, visited: []int{1, 2}},
+		{name: "empty", input: []int{}, stopAt: 1, completed: true, visited: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var visited []int
+			completed := ForEachWhile(NewMockCollection(tt.input), func(i int) bool {
+				visited = append(visited, i)
+				return i != tt.stopAt
+			})
+			if completed != tt.completed || !slices.Equal(visited, tt.visited) {
+				t.Errorf("ForEachWhile() = %v visiting %v, want %v visiting %v", completed, visited, tt.completed, tt.visited)
+			}
+		})
+	}
+}
+
+func TestCountValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		value int
+		count int
+	}{
+		{name: "multiple occurrences", input: []int{1, 2, 2, 3, 2}, value: 2, count: 3},
+		{name: "no occurrence", input: []int{1, 3, 5}, value: 2, count: 0},
+		{name: "empty collection", input: []int{}, value: 2, count: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountValue(NewMockCollection(tt.input), tt.value); got != tt.count {
+				t.Errorf("CountValue() = %v, want %v", got, tt.count)
+			}
+		})
+	}
+}
+
 func TestDiff(t *testing.T) {
 	tests := []struct {
 		name string
@@ -92,6 +196,78 @@ func TestDistinct(t *testing.T) {
 	}
 }
 
+func TestDistinctKeepLast(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []int
+		want []int
+	}{
+		{name: "keeps last occurrences", a: []int{1, 2, 1, 3, 2}, want: []int{1, 3, 2}},
+		{name: "no duplicates", a: []int{1, 2, 3}, want: []int{1, 2, 3}},
+		{name: "all duplicates", a: []int{4, 4, 4}, want: []int{4}},
+		{name: "empty collection", a: []int{}, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DistinctKeepLast(NewMockCollection(tt.a)).(*MockCollection[int]).items
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("DistinctKeepLast() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistinctByKeepLast(t *testing.T) {
+	type record struct {
+		id      int
+		version string
+	}
+	c := NewMockCollection([]record{{1, "a"}, {2, "b"}, {1, "c"}, {3, "d"}, {2, "e"}})
+	got := DistinctByKeepLast(c, func(r record) int { return r.id }).(*MockCollection[record]).items
+	want := []record{{1, "c"}, {3, "d"}, {2, "e"}}
+	if !slices.Equal(got, want) {
+		t.Errorf("DistinctByKeepLast() = %v, want %v", got, want)
+	}
+}
+
+// shuffledCollection is an unordered collection that iterates in
+// a different order every time, like a map-backed set.
+type shuffledCollection[T any] struct {
+	*MockCollection[T]
+	calls int
+}
+
+func (s *shuffledCollection[T]) Values() iter.Seq[T] {
+	s.calls++
+	if s.calls%2 == 0 {
+		return func(yield func(T) bool) {
+			for _, v := range slices.Backward(s.items) {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+	return s.MockCollection.Values()
+}
+
+func TestDistinctByKeepLast_Unordered(t *testing.T) {
+	c := &shuffledCollection[string]{MockCollection: NewMockCollection([]string{"a1", "b1", "a2"})}
+	got := DistinctByKeepLast[string](c, func(s string) byte { return s[0] }).(*MockCollection[string]).items
+	// the last element of each key in the single iteration order observed.
+	if want := []string{"b1", "a2"}; !slices.Equal(got, want) {
+		t.Errorf("DistinctByKeepLast() = %v, want %v", got, want)
+	}
+}
+
+func TestDistinctKeepLast_NaN(t *testing.T) {
+	c := NewMockCollection([]float64{1, math.NaN(), 2, math.NaN(), 1})
+	got := DistinctKeepLast[float64](c).(*MockCollection[float64]).items
+	if len(got) != 4 || !math.IsNaN(got[0]) || got[1] != 2 || !math.IsNaN(got[2]) || got[3] != 1 {
+		t.Errorf("DistinctKeepLast() = %v, want %v", got, []float64{math.NaN(), 2, math.NaN(), 1})
+	}
+}
+
 func TestReduce(t *testing.T) {
 	sum := func(acc, curr int) int { return acc + curr }
 
@@ -237,6 +413,55 @@ func TestForAll(t *testing.T) {
 	}
 }
 
+func TestAnyAllNone(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+	tests := []struct {
+		name  string
+		input []int
+		any   bool
+		all   bool
+		none  bool
+	}{
+		{name: "all even", input: []int{2, 4, 6}, any: true, all: true, none: false},
+		{name: "some even", input: []int{1, 2, 3}, any: true, all: false, none: false},
+		{name: "no even", input: []int{1, 3, 5}, any: false, all: false, none: true},
+		{name: "empty", input: []int{}, any: false, all: true, none: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewMockCollection(tt.input)
+			if got := Any(c, isEven); got != tt.any {
+				t.Errorf("Any() = %v, want %v", got, tt.any)
+			}
+			if got := All(c, isEven); got != tt.all {
+				t.Errorf("All() = %v, want %v", got, tt.all)
+			}
+			if got := None(c, isEven); got != tt.none {
+				t.Errorf("None() = %v, want %v", got, tt.none)
+			}
+			bools := NewMockCollection(Map(c, isEven))
+			if got := AnyTrue(bools); got != tt.any {
+				t.Errorf("AnyTrue() = %v, want %v", got, tt.any)
+			}
+			if got := AllTrue(bools); got != tt.all {
+				t.Errorf("AllTrue() = %v, want %v", got, tt.all)
+			}
+			if got := NoneTrue(bools); got != tt.none {
+				t.Errorf("NoneTrue() = %v, want %v", got, tt.none)
+			}
+		})
+	}
+
+	calls := 0
+	None(NewMockCollection([]int{1, 2, 3, 4}), func(n int) bool {
+		calls++
+		return isEven(n)
+	})
+	if calls != 2 {
+		t.Errorf("None() called predicate %v times, want %v", calls, 2)
+	}
+}
+
 func TestGroupBy(t *testing.T) {
 	modTwo := func(n int) int { return n % 2 }
 	tests := []struct {
@@ -273,6 +498,37 @@ func TestGroupBy(t *testing.T) {
 	}
 }
 
+func TestFrequenciesMostCommon(t *testing.T) {
+	c := NewMockCollection([]string{"a", "b", "a", "c", "a", "b", "d"})
+	freq := Frequencies(c)
+	want := map[string]int{"a": 3, "b": 2, "c": 1, "d": 1}
+	if !maps.Equal(freq, want) {
+		t.Errorf("Frequencies() = %v, want %v", freq, want)
+	}
+
+	tests := []struct {
+		name string
+		n    int
+		want []Frequency[string]
+	}{
+		{name: "top two", n: 2, want: []Frequency[string]{{"a", 3}, {"b", 2}}},
+		{name: "ties keep first occurrence order", n: 3, want: []Frequency[string]{{"a", 3}, {"b", 2}, {"c", 1}}},
+		{name: "zero", n: 0, want: []Frequency[string]{}},
+		{name: "negative returns all", n: -1, want: []Frequency[string]{{"a", 3}, {"b", 2}, {"c", 1}, {"d", 1}}},
+		{name: "more than distinct", n: 10, want: []Frequency[string]{{"a", 3}, {"b", 2}, {"c", 1}, {"d", 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MostCommon(c, tt.n); !slices.Equal(got, tt.want) {
+				t.Errorf("MostCommon() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if got := MostCommon(NewMockCollection([]int{}), 1); len(got) != 0 {
+		t.Errorf("MostCommon() = %v, want %v", got, []Frequency[int]{})
+	}
+}
+
 func TestIntersect(t *testing.T) {
 	tests := []struct {
 		name string
@@ -378,6 +634,48 @@ func TestMap(t *testing.T) {
 	}
 }
 
+func TestMapCached(t *testing.T) {
+	calls := 0
+	square := func(i int) int {
+		calls++
+		return i * i
+	}
+	identity := func(i int) int { return i }
+
+	got := MapCached(NewMockCollection([]int{2, 3, 2, 2, 3}), square, identity)
+	if want := []int{4, 9, 4, 4, 9}; !slices.Equal(got, want) {
+		t.Errorf("MapCached() = %v, want %v", got, want)
+	}
+	if calls != 2 {
+		t.Errorf("MapCached() called function %v times, want %v", calls, 2)
+	}
+
+	calls = 0
+	cache := make(map[int]int)
+	MapCachedWith(NewMockCollection([]int{1, 2}), square, identity, cache)
+	got = MapCachedWith(NewMockCollection([]int{2, 3, 1}), square, identity, cache)
+	if want := []int{4, 9, 1}; !slices.Equal(got, want) {
+		t.Errorf("MapCachedWith() = %v, want %v", got, want)
+	}
+	if calls != 3 {
+		t.Errorf("MapCachedWith() called function %v times, want %v", calls, 3)
+	}
+	if len(cache) != 3 {
+		t.Errorf("MapCachedWith() cache length = %v, want %v", len(cache), 3)
+	}
+}
+
+func TestMapInto(t *testing.T) {
+	got := MapInto(
+		NewMockCollection([]string{"Alice", "Bob", "Charlie"}),
+		func(s string) int { return len(s) },
+		NewMockCollection[int](),
+	)
+	if !slices.Equal(got.items, []int{5, 3, 7}) {
+		t.Errorf("MapInto() = %v, want %v", got.items, []int{5, 3, 7})
+	}
+}
+
 func TestMaxBy(t *testing.T) {
 	identity := func(a int) int { return a }
 	tests := []struct {
@@ -508,3 +806,288 @@ func TestPartition(t *testing.T) {
 		})
 	}
 }
+
+func TestRequireDistinctBy(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []string
+		keys    []byte
+		indices map[byte][]int
+		message string
+	}{
+		{
+			name:  "distinct keys",
+			input: []string{"apple", "banana", "cherry"},
+		},
+		{
+			name:  "empty collection",
+			input: []string{},
+		},
+		{
+			name:    "duplicate keys",
+			input:   []string{"apple", "banana", "avocado", "cherry", "blueberry", "apricot"},
+			keys:    []byte{'a', 'b'},
+			indices: map[byte][]int{'a': {0, 2, 5}, 'b': {1, 4}},
+			message: "duplicate keys: 97 at [0 2 5], 98 at [1 4]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RequireDistinctBy(NewMockCollection(tt.input), func(s string) byte { return s[0] })
+			if tt.keys == nil {
+				if err != nil {
+					t.Errorf("RequireDistinctBy() = %v, want nil", err)
+				}
+				return
+			}
+			dup, ok := err.(*DuplicateKeyError[byte])
+			if !ok {
+				t.Fatalf("RequireDistinctBy() = %v, want a *DuplicateKeyError", err)
+			}
+			if !slices.Equal(dup.Keys, tt.keys) {
+				t.Errorf("RequireDistinctBy() keys = %v, want %v", dup.Keys, tt.keys)
+			}
+			for k, want := range tt.indices {
+				if !slices.Equal(dup.Indices[k], want) {
+					t.Errorf("RequireDistinctBy() indices of %v = %v, want %v", k, dup.Indices[k], want)
+				}
+			}
+			if err.Error() != tt.message {
+				t.Errorf("Error() = %q, want %q", err.Error(), tt.message)
+			}
+		})
+	}
+}
+
+func TestShard(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		n     int
+		want  [][]int
+	}{
+		{name: "uneven", input: []int{1, 2, 3, 4, 5, 6, 7}, n: 3, want: [][]int{{1, 2, 3}, {4, 5}, {6, 7}}},
+		{name: "even", input: []int{1, 2, 3, 4}, n: 2, want: [][]int{{1, 2}, {3, 4}}},
+		{name: "fewer elements than shards", input: []int{1, 2}, n: 4, want: [][]int{{1}, {2}, {}, {}}},
+		{name: "single shard", input: []int{1, 2, 3}, n: 1, want: [][]int{{1, 2, 3}}},
+		{name: "empty", input: []int{}, n: 2, want: [][]int{{}, {}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shards := Shard(NewMockCollection(tt.input), tt.n)
+			if len(shards) != len(tt.want) {
+				t.Fatalf("Shard() returned %v shards, want %v", len(shards), len(tt.want))
+			}
+			for i, shard := range shards {
+				if got := shard.(*MockCollection[int]).items; !slices.Equal(got, tt.want[i]) {
+					t.Errorf("Shard()[%v] = %v, want %v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+
+	defer func() {
+		if r := recover(); r != InvalidArgumentError {
+			t.Errorf("Shard() panic = %v, want %v", r, InvalidArgumentError)
+		}
+	}()
+	Shard(NewMockCollection([]int{1}), 0)
+}
+
+func TestShardBy(t *testing.T) {
+	type event struct {
+		user string
+		id   int
+	}
+	c := NewMockCollection([]event{
+		{"ann", 1}, {"bob", 2}, {"ann", 3}, {"cid", 4}, {"bob", 5}, {"dan", 6}, {"ann", 7},
+	})
+	shards := ShardBy(c, func(e event) string { return e.user }, 3)
+	if len(shards) != 3 {
+		t.Fatalf("ShardBy() returned %v shards, want %v", len(shards), 3)
+	}
+	seen := make(map[string]int)
+	total := 0
+	for i, shard := range shards {
+		prev := 0
+		for e := range shard.Values() {
+			if j, ok := seen[e.user]; ok && j != i {
+				t.Errorf("ShardBy() put user %v in shards %v and %v", e.user, j, i)
+			}
+			seen[e.user] = i
+			if e.id < prev {
+				t.Errorf("ShardBy() did not preserve order in shard %v", i)
+			}
+			prev = e.id
+			total++
+		}
+	}
+	if total != c.Length() {
+		t.Errorf("ShardBy() distributed %v elements, want %v", total, c.Length())
+	}
+
+	again := ShardBy(c, func(e event) string { return e.user }, 3)
+	for i := range shards {
+		if !slices.Equal(shards[i].(*MockCollection[event]).items, again[i].(*MockCollection[event]).items) {
+			t.Errorf("ShardBy() is not deterministic, shard %v = %v then %v", i, shards[i], again[i])
+		}
+	}
+}
+
+func TestShardBy_Keys(t *testing.T) {
+	c := NewMockCollection([]float64{math.Copysign(0, -1), 0, 1.5, 1.5})
+	shards := ShardBy(c, func(f float64) float64 { return f }, 8)
+	for _, shard := range shards {
+		if n := shard.Length(); n != 0 && n != 2 {
+			t.Errorf("ShardBy() = %v, want equal keys in the same shard", shards)
+		}
+	}
+	words := NewMockCollection(slices.Repeat([]string{"ann", "bob"}, 500))
+	// the hasher and key buffer are reused, string keys are hashed without allocating.
+	if allocs := testing.AllocsPerRun(10, func() { ShardBy(words, func(s string) string { return s }, 2) }); allocs > 100 {
+		t.Errorf("ShardBy() made %v allocations for %v elements, want at most %v", allocs, words.Length(), 100)
+	}
+}
+
+func TestDiffByIntersectBy(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	byID := func(u user) int { return u.id }
+	tests := []struct {
+		name      string
+		a         []user
+		b         []user
+		diff      []user
+		intersect []user
+	}{
+		{
+			name:      "overlapping keys",
+			a:         []user{{1, "a"}, {2, "b"}, {3, "c"}},
+			b:         []user{{2, "other"}, {4, "d"}},
+			diff:      []user{{1, "a"}, {3, "c"}},
+			intersect: []user{{2, "b"}},
+		},
+		{
+			name:      "empty second collection",
+			a:         []user{{1, "a"}},
+			b:         []user{},
+			diff:      []user{{1, "a"}},
+			intersect: nil,
+		},
+		{
+			name:      "empty first collection",
+			a:         []user{},
+			b:         []user{{1, "a"}},
+			diff:      nil,
+			intersect: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := DiffBy(NewMockCollection(tt.a), NewMockCollection(tt.b), byID).(*MockCollection[user]).items
+			if !slices.Equal(diff, tt.diff) {
+				t.Errorf("DiffBy() = %v, want %v", diff, tt.diff)
+			}
+			intersect := IntersectBy(NewMockCollection(tt.a), NewMockCollection(tt.b), byID).(*MockCollection[user]).items
+			if !slices.Equal(intersect, tt.intersect) {
+				t.Errorf("IntersectBy() = %v, want %v", intersect, tt.intersect)
+			}
+		})
+	}
+}
+
+func TestSumByProductByJoinBy(t *testing.T) {
+	type item struct {
+		name  string
+		qty   int
+		price float64
+	}
+	tests := []struct {
+		name    string
+		input   []item
+		sum     float64
+		product int
+		joined  string
+	}{
+		{
+			name:    "items",
+			input:   []item{{"a", 2, 1.5}, {"b", 3, 2.5}, {"c", 4, 1}},
+			sum:     5,
+			product: 24,
+			joined:  "a, b, c",
+		},
+		{
+			name:    "empty collection",
+			input:   []item{},
+			sum:     0,
+			product: 1,
+			joined:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewMockCollection(tt.input)
+			if got := SumBy(c, func(i item) float64 { return i.price }); got != tt.sum {
+				t.Errorf("SumBy() = %v, want %v", got, tt.sum)
+			}
+			if got := ProductBy(c, func(i item) int { return i.qty }); got != tt.product {
+				t.Errorf("ProductBy() = %v, want %v", got, tt.product)
+			}
+			if got := JoinBy(c, func(i item) string { return i.name }, ", "); got != tt.joined {
+				t.Errorf("JoinBy() = %q, want %q", got, tt.joined)
+			}
+		})
+	}
+}
+
+func TestFindOr(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  int
+	}{
+		{name: "found", input: []int{1, 4, 6}, want: 4},
+		{name: "not found", input: []int{1, 3}, want: -1},
+		{name: "empty", input: []int{}, want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FindOr(NewMockCollection(tt.input), func(i int) bool { return i%2 == 0 }, -1)
+			if got != tt.want {
+				t.Errorf("FindOr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTapInspectEach(t *testing.T) {
+	c := NewMockCollection([]int{1, 2, 3})
+
+	var tapped int
+	if got := Tap(c, func(c Collection[int]) { tapped = c.Length() }); got != Collection[int](c) {
+		t.Errorf("Tap() did not return the collection")
+	}
+	if tapped != 3 {
+		t.Errorf("Tap() called with a collection of length %v, want %v", tapped, 3)
+	}
+
+	var indices, values []int
+	if got := InspectEach(c, func(i int, v int) {
+		indices = append(indices, i)
+		values = append(values, v)
+	}); got != Collection[int](c) {
+		t.Errorf("InspectEach() did not return the collection")
+	}
+	if !slices.Equal(indices, []int{0, 1, 2}) || !slices.Equal(values, []int{1, 2, 3}) {
+		t.Errorf("InspectEach() = %v %v, want %v %v", indices, values, []int{0, 1, 2}, []int{1, 2, 3})
+	}
+}
+
+func TestKeepIndex(t *testing.T) {
+	f := KeepIndex(func(i int) []int { return []int{i, i} })
+	if got := f(Indexed[int]{Index: 4, Value: 2}); got.Index != 4 || !slices.Equal(got.Value, []int{2, 2}) {
+		t.Errorf("KeepIndex() = %v, want %v", got, Indexed[[]int]{Index: 4, Value: []int{2, 2}})
+	}
+}
@@ -28,10 +28,14 @@ import "iter"
 func Concatenated[T any](s1, s2 Collection[T]) iter.Seq[T] {
 	return func(yield func(T) bool) {
 		for v := range s1.Values() {
-			yield(v)
+			if !yield(v) {
+				return
+			}
 		}
 		for v := range s2.Values() {
-			yield(v)
+			if !yield(v) {
+				return
+			}
 		}
 	}
 }
@@ -55,8 +59,8 @@ func Diffed[T comparable](s1 OrderedCollection[T], s2 OrderedCollection[T]) iter
 	return func(yield func(T) bool) {
 		for v := range s1.Values() {
 			i, _ := Find(s2, func(t T) bool { return t == v })
-			if i == -1 {
-				yield(v)
+			if i == -1 && !yield(v) {
+				return
 			}
 		}
 	}
@@ -84,8 +88,8 @@ func DiffedFunc[T any](s1 OrderedCollection[T], s2 OrderedCollection[T], f func(
 	return func(yield func(T) bool) {
 		for v := range s1.Values() {
 			i, _ := Find(s2, func(t T) bool { return f(v, t) })
-			if i == -1 {
-				yield(v)
+			if i == -1 && !yield(v) {
+				return
 			}
 		}
 	}
@@ -106,12 +110,14 @@ func DiffedFunc[T any](s1 OrderedCollection[T], s2 OrderedCollection[T], f func(
 //	2
 //	3
 func Distincted[T comparable](s Collection[T]) iter.Seq[T] {
-	seen := make(map[T]bool)
 	return func(yield func(T) bool) {
+		seen := make(map[T]bool)
 		for v := range s.Values() {
 			if !seen[v] {
 				seen[v] = true
-				yield(v)
+				if !yield(v) {
+					return
+				}
 			}
 		}
 	}
@@ -135,8 +141,8 @@ func Distincted[T comparable](s Collection[T]) iter.Seq[T] {
 //	2
 //	3
 func DistinctedFunc[T any](s Collection[T], f func(T, T) bool) iter.Seq[T] {
-	s2 := s.New()
 	return func(yield func(T) bool) {
+		s2 := s.New()
 		for v := range s.Values() {
 			match := false
 			for v2 := range s2.Values() {
@@ -147,7 +153,9 @@ func DistinctedFunc[T any](s Collection[T], f func(T, T) bool) iter.Seq[T] {
 			}
 			if !match {
 				s2.Add(v)
-				yield(v)
+				if !yield(v) {
+					return
+				}
 			}
 		}
 	}
@@ -171,8 +179,45 @@ func DistinctedFunc[T any](s Collection[T], f func(T, T) bool) iter.Seq[T] {
 func Filtered[T any](s Collection[T], f func(T) bool) iter.Seq[T] {
 	return func(yield func(T) bool) {
 		for v := range s.Values() {
-			if f(v) {
-				yield(v)
+			if f(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// GroupedAll returns an iterator over the groups of elements of s sharing the same key,
+// in the order in which each key is first encountered. Unlike GroupBy, the order of
+// the groups is deterministic. Grouping is deferred until the iterator is consumed,
+// and each group is a collection constructed using s.New().
+//
+// example usage:
+//
+//	a := NewList([]int{1,2,3,4,5,6})
+//	for k, group := range GroupedAll(a, func(i int) string { return fmt.Sprint(i % 3) }) {
+//		fmt.Println(k, group)
+//	}
+//
+// output:
+//
+//	1 [1,4]
+//	2 [2,5]
+//	0 [3,6]
+func GroupedAll[T any, K comparable](s Collection[T], f func(T) K) iter.Seq2[K, Collection[T]] {
+	return func(yield func(K, Collection[T]) bool) {
+		var keys []K
+		groups := make(map[K]Collection[T])
+		for v := range s.Values() {
+			k := f(v)
+			if _, ok := groups[k]; !ok {
+				keys = append(keys, k)
+				groups[k] = s.New()
+			}
+			groups[k].Add(v)
+		}
+		for _, k := range keys {
+			if !yield(k, groups[k]) {
+				return
 			}
 		}
 	}
@@ -197,8 +242,8 @@ func Intersected[T comparable](s1 Collection[T], s2 Collection[T]) iter.Seq[T] {
 	return func(yield func(T) bool) {
 		for v := range s1.Values() {
 			for v2 := range s2.Values() {
-				if v == v2 {
-					yield(v)
+				if v == v2 && !yield(v) {
+					return
 				}
 			}
 		}
@@ -226,8 +271,8 @@ func IntersectedFunc[T any](s1 Collection[T], s2 Collection[T], f func(T, T) boo
 	return func(yield func(T) bool) {
 		for v := range s1.Values() {
 			for v2 := range s2.Values() {
-				if f(v, v2) {
-					yield(v)
+				if f(v, v2) && !yield(v) {
+					return
 				}
 			}
 		}
@@ -252,7 +297,36 @@ func IntersectedFunc[T any](s1 Collection[T], s2 Collection[T], f func(T, T) boo
 func Mapped[T, K any](s Collection[T], f func(T) K) iter.Seq[K] {
 	return func(yield func(K) bool) {
 		for v := range s.Values() {
-			yield(f(v))
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// Pairwise returns an iterator over the pairs of consecutive elements of s,
+// yielding each element along with the one preceding it.
+//
+// example usage:
+//
+//	a := NewList([]int{1,4,9})
+//	for prev, cur := range Pairwise(a) {
+//		fmt.Println(prev, cur)
+//	}
+//
+// output:
+//
+//	1 4
+//	4 9
+func Pairwise[T any](s OrderedCollection[T]) iter.Seq2[T, T] {
+	return func(yield func(T, T) bool) {
+		var prev T
+		first := true
+		for v := range s.Values() {
+			if !first && !yield(prev, v) {
+				return
+			}
+			prev, first = v, false
 		}
 	}
 }
@@ -275,3 +349,152 @@ func Mapped[T, K any](s Collection[T], f func(T) K) iter.Seq[K] {
 func Rejected[T any](s Collection[T], f func(T) bool) iter.Seq[T] {
 	return Filtered(s, func(t T) bool { return !f(t) })
 }
+
+// Zip returns an iterator over pairs of elements of s1 and s2 at the same position.
+// Iteration stops at the end of the shorter collection.
+//
+// example usage:
+//
+//	a := NewList([]int{1,2,3})
+//	b := NewList([]string{"a","b"})
+//	for x, y := range Zip(a, b) {
+//		fmt.Println(x, y)
+//	}
+//
+// output:
+//
+//	1 a
+//	2 b
+func Zip[T, K any](s1 OrderedCollection[T], s2 OrderedCollection[K]) iter.Seq2[T, K] {
+	return func(yield func(T, K) bool) {
+		next, stop := iter.Pull(s2.Values())
+		defer stop()
+		for v1 := range s1.Values() {
+			v2, ok := next()
+			if !ok || !yield(v1, v2) {
+				return
+			}
+		}
+	}
+}
+
+// ZipAll is similar to Zip but continues until the end of the longer collection,
+// using fillA in place of missing elements of s1 and fillB in place of missing elements of s2.
+//
+// example usage:
+//
+//	a := NewList([]int{1,2,3})
+//	b := NewList([]string{"a"})
+//	for x, y := range ZipAll(a, b, 0, "-") {
+//		fmt.Println(x, y)
+//	}
+//
+// output:
+//
+//	1 a
+//	2 -
+//	3 -
+func ZipAll[T, K any](s1 OrderedCollection[T], s2 OrderedCollection[K], fillA T, fillB K) iter.Seq2[T, K] {
+	return func(yield func(T, K) bool) {
+		next1, stop1 := iter.Pull(s1.Values())
+		defer stop1()
+		next2, stop2 := iter.Pull(s2.Values())
+		defer stop2()
+		for {
+			v1, ok1 := next1()
+			v2, ok2 := next2()
+			if !ok1 && !ok2 {
+				return
+			}
+			if !ok1 {
+				v1 = fillA
+			}
+			if !ok2 {
+				v2 = fillB
+			}
+			if !yield(v1, v2) {
+				return
+			}
+		}
+	}
+}
+
+// Triple holds three values, such as the elements at the same position of three collections.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Zip3 returns an iterator over triples of the elements at the same position in three
+// collections, stopping at the end of the shortest one. It is useful to align parallel
+// collections such as ids, timestamps and values returned separately by an API.
+//
+// example usage:
+//
+//	ids := NewSequence([]int{1,2,3})
+//	names := NewSequence([]string{"a","b","c"})
+//	scores := NewSequence([]float64{0.5,0.9})
+//	for t := range Zip3(ids, names, scores) {
+//		fmt.Println(t.First, t.Second, t.Third)
+//	}
+//
+// output:
+//
+//	1 a 0.5
+//	2 b 0.9
+func Zip3[A, B, C any](s1 OrderedCollection[A], s2 OrderedCollection[B], s3 OrderedCollection[C]) iter.Seq[Triple[A, B, C]] {
+	return func(yield func(Triple[A, B, C]) bool) {
+		next2, stop2 := iter.Pull(s2.Values())
+		defer stop2()
+		next3, stop3 := iter.Pull(s3.Values())
+		defer stop3()
+		for v1 := range s1.Values() {
+			v2, ok2 := next2()
+			v3, ok3 := next3()
+			if !ok2 || !ok3 || !yield(Triple[A, B, C]{v1, v2, v3}) {
+				return
+			}
+		}
+	}
+}
+
+// SplitWhenKeyChangesSeq returns an iterator over the runs of consecutive elements of s
+// sharing the same key, along with that key. It is the lazy form of SplitWhenKeyChanges,
+// each run is yielded as soon as the key changes, which makes it suitable for segmenting
+// streams sorted by key.
+//
+// example usage:
+//
+//	a := NewList([]int{1,3,2,4,5})
+//	for odd, run := range SplitWhenKeyChangesSeq(a, func(i int) bool { return i % 2 == 1 }) {
+//		fmt.Println(odd, run)
+//	}
+//
+// output:
+//
+//	true [1,3]
+//	false [2,4]
+//	true [5]
+func SplitWhenKeyChangesSeq[T any, K comparable](s OrderedCollection[T], f func(T) K) iter.Seq2[K, OrderedCollection[T]] {
+	return func(yield func(K, OrderedCollection[T]) bool) {
+		var key K
+		var run OrderedCollection[T]
+		for v := range s.Values() {
+			k := f(v)
+			if run != nil && k != key {
+				if !yield(key, run) {
+					return
+				}
+				run = nil
+			}
+			if run == nil {
+				key, run = k, s.NewOrdered()
+			}
+			run.Add(v)
+		}
+		if run != nil {
+			yield(key, run)
+		}
+	}
+}
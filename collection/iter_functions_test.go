@@ -1,6 +1,7 @@
 package collection
 
 import (
+	"iter"
 	"slices"
 	"testing"
 )
@@ -373,3 +374,246 @@ func TestRejected(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupedAll(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []int
+		keys   []int
+		groups [][]int
+	}{
+		{
+			name:   "groups in first-encounter order",
+			input:  []int{5, 1, 2, 3, 4, 6, 7},
+			keys:   []int{2, 1, 0},
+			groups: [][]int{{5, 2}, {1, 4, 7}, {3, 6}},
+		},
+		{
+			name:  "empty collection",
+			input: []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var keys []int
+			var groups [][]int
+			for k, g := range GroupedAll(NewMockCollection(tt.input), func(i int) int { return i % 3 }) {
+				keys = append(keys, k)
+				groups = append(groups, g.(*MockCollection[int]).items)
+			}
+			if !slices.Equal(keys, tt.keys) {
+				t.Errorf("GroupedAll() keys = %v, want %v", keys, tt.keys)
+			}
+			if !slices.EqualFunc(groups, tt.groups, slices.Equal) {
+				t.Errorf("GroupedAll() groups = %v, want %v", groups, tt.groups)
+			}
+		})
+	}
+}
+
+func TestGroupedAllBreak(t *testing.T) {
+	var keys []int
+	for k := range GroupedAll(NewMockCollection([]int{1, 2, 3}), func(i int) int { return i }) {
+		keys = append(keys, k)
+		if k == 2 {
+			break
+		}
+	}
+	if !slices.Equal(keys, []int{1, 2}) {
+		t.Errorf("GroupedAll() keys = %v, want %v", keys, []int{1, 2})
+	}
+}
+
+func TestIteratorsBreakEarly(t *testing.T) {
+	a := NewMockOrderedCollection([]int{1, 2, 2, 3, 4, 5, 6})
+	b := NewMockOrderedCollection([]int{2, 4, 6, 8})
+	eq := func(x, y int) bool { return x == y }
+	even := func(i int) bool { return i%2 == 0 }
+	tests := []struct {
+		name string
+		seq  iter.Seq[int]
+		want []int
+	}{
+		{name: "Concatenated", seq: Concatenated[int](a, b), want: []int{1, 2}},
+		{name: "Concatenated second collection", seq: Concatenated[int](NewMockCollection([]int{}), b), want: []int{2, 4}},
+		{name: "Diffed", seq: Diffed[int](a, b), want: []int{1, 3}},
+		{name: "DiffedFunc", seq: DiffedFunc[int](a, b, eq), want: []int{1, 3}},
+		{name: "Distincted", seq: Distincted[int](a), want: []int{1, 2}},
+		{name: "DistinctedFunc", seq: DistinctedFunc[int](a, eq), want: []int{1, 2}},
+		{name: "Filtered", seq: Filtered[int](a, even), want: []int{2, 2}},
+		{name: "Intersected", seq: Intersected[int](a, b), want: []int{2, 2}},
+		{name: "IntersectedFunc", seq: IntersectedFunc[int](a, b, eq), want: []int{2, 2}},
+		{name: "Mapped", seq: Mapped[int](a, func(i int) int { return i * 10 }), want: []int{10, 20}},
+		{name: "Rejected", seq: Rejected[int](a, even), want: []int{1, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for v := range tt.seq {
+				got = append(got, v)
+				if len(got) == 2 {
+					break
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistinctedReiterate(t *testing.T) {
+	seq := Distincted[int](NewMockCollection([]int{1, 1, 2, 3}))
+	for range seq {
+		break
+	}
+	if got := slices.Collect(seq); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Distincted() = %v, want %v", got, []int{1, 2, 3})
+	}
+}
+
+func TestZip(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     []int
+		b     []string
+		wantA []int
+		wantB []string
+	}{
+		{name: "equal lengths", a: []int{1, 2}, b: []string{"a", "b"}, wantA: []int{1, 2}, wantB: []string{"a", "b"}},
+		{name: "shorter second", a: []int{1, 2, 3}, b: []string{"a"}, wantA: []int{1}, wantB: []string{"a"}},
+		{name: "shorter first", a: []int{1}, b: []string{"a", "b"}, wantA: []int{1}, wantB: []string{"a"}},
+		{name: "empty", a: []int{}, b: []string{"a"}, wantA: nil, wantB: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotA []int
+			var gotB []string
+			for x, y := range Zip[int, string](NewMockOrderedCollection(tt.a), NewMockOrderedCollection(tt.b)) {
+				gotA = append(gotA, x)
+				gotB = append(gotB, y)
+			}
+			if !slices.Equal(gotA, tt.wantA) || !slices.Equal(gotB, tt.wantB) {
+				t.Errorf("Zip() = %v %v, want %v %v", gotA, gotB, tt.wantA, tt.wantB)
+			}
+		})
+	}
+}
+
+func TestZipAll(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     []int
+		b     []string
+		wantA []int
+		wantB []string
+	}{
+		{name: "equal lengths", a: []int{1, 2}, b: []string{"a", "b"}, wantA: []int{1, 2}, wantB: []string{"a", "b"}},
+		{name: "shorter second", a: []int{1, 2, 3}, b: []string{"a"}, wantA: []int{1, 2, 3}, wantB: []string{"a", "-", "-"}},
+		{name: "shorter first", a: []int{1}, b: []string{"a", "b"}, wantA: []int{1, 0}, wantB: []string{"a", "b"}},
+		{name: "both empty", a: []int{}, b: []string{}, wantA: nil, wantB: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotA []int
+			var gotB []string
+			for x, y := range ZipAll[int, string](NewMockOrderedCollection(tt.a), NewMockOrderedCollection(tt.b), 0, "-") {
+				gotA = append(gotA, x)
+				gotB = append(gotB, y)
+			}
+			if !slices.Equal(gotA, tt.wantA) || !slices.Equal(gotB, tt.wantB) {
+				t.Errorf("ZipAll() = %v %v, want %v %v", gotA, gotB, tt.wantA, tt.wantB)
+			}
+		})
+	}
+}
+
+func TestZip3(t *testing.T) {
+	ids := NewMockOrderedCollection([]int{1, 2, 3})
+	names := NewMockOrderedCollection([]string{"a", "b", "c"})
+	scores := NewMockOrderedCollection([]float64{0.5, 0.9})
+	var got []Triple[int, string, float64]
+	for v := range Zip3[int, string, float64](ids, names, scores) {
+		got = append(got, v)
+	}
+	want := []Triple[int, string, float64]{{1, "a", 0.5}, {2, "b", 0.9}}
+	if !slices.Equal(got, want) {
+		t.Errorf("Zip3() = %v, want %v", got, want)
+	}
+
+	gotIds, gotNames, gotScores := Unzip3(slices.Values(got))
+	if !slices.Equal(gotIds, []int{1, 2}) || !slices.Equal(gotNames, []string{"a", "b"}) || !slices.Equal(gotScores, []float64{0.5, 0.9}) {
+		t.Errorf("Unzip3() = %v %v %v, want %v %v %v", gotIds, gotNames, gotScores, []int{1, 2}, []string{"a", "b"}, []float64{0.5, 0.9})
+	}
+
+	for range Zip3[int, string, float64](ids, names, scores) {
+		break
+	}
+	if a, b, c := Unzip3(Zip3[int, string, float64](ids, names, NewMockOrderedCollection([]float64{}))); a != nil || b != nil || c != nil {
+		t.Errorf("Unzip3() = %v %v %v, want empty slices", a, b, c)
+	}
+}
+
+func TestZipAllBreak(t *testing.T) {
+	count := 0
+	for range ZipAll[int, int](NewMockOrderedCollection([]int{1, 2, 3}), NewMockOrderedCollection([]int{}), 0, 0) {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	if count != 2 {
+		t.Errorf("ZipAll() yielded %v values, want %v", count, 2)
+	}
+}
+
+func TestSplitWhenKeyChangesSeq(t *testing.T) {
+	type event struct {
+		user   string
+		action string
+	}
+	events := NewMockOrderedCollection([]event{
+		{"ann", "login"}, {"ann", "view"}, {"bob", "login"}, {"ann", "logout"},
+	})
+	var users []string
+	var sizes []int
+	for user, run := range SplitWhenKeyChangesSeq(events, func(e event) string { return e.user }) {
+		users = append(users, user)
+		sizes = append(sizes, run.Length())
+	}
+	if !slices.Equal(users, []string{"ann", "bob", "ann"}) || !slices.Equal(sizes, []int{2, 1, 1}) {
+		t.Errorf("SplitWhenKeyChangesSeq() = %v %v, want %v %v", users, sizes, []string{"ann", "bob", "ann"}, []int{2, 1, 1})
+	}
+
+	count := 0
+	for range SplitWhenKeyChangesSeq(events, func(e event) string { return e.user }) {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("SplitWhenKeyChangesSeq() yielded %v runs after break, want %v", count, 1)
+	}
+}
+
+func TestPairwise(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  [][2]int
+	}{
+		{name: "three elements", input: []int{1, 4, 9}, want: [][2]int{{1, 4}, {4, 9}}},
+		{name: "single element", input: []int{1}, want: nil},
+		{name: "empty", input: []int{}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got [][2]int
+			for prev, cur := range Pairwise[int](NewMockOrderedCollection(tt.input)) {
+				got = append(got, [2]int{prev, cur})
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Pairwise() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
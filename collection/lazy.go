@@ -0,0 +1,43 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package collection
+
+import "sync"
+
+// LazyCollection is a handle to a collection that is built on first use.
+// It is safe for concurrent use, the collection is built exactly once.
+type LazyCollection[C any] struct {
+	once sync.Once
+	load func() C
+	c    C
+}
+
+// Lazy returns a handle whose Get method builds the collection by calling f
+// the first time it is invoked and returns the same collection afterwards.
+// It is intended for package-level reference data built by expensive loading code.
+// If f panics, Get panics with the same value and subsequent calls return the zero value of C.
+//
+// example usage:
+//
+//	var countries = Lazy(func() *Sequence[string] {
+//		return loadCountries()
+//	})
+//
+//	func IsCountry(name string) bool {
+//		return countries.Get().Contains(name)
+//	}
+func Lazy[C any](f func() C) *LazyCollection[C] {
+	return &LazyCollection[C]{load: f}
+}
+
+// Get returns the collection, building it on the first call.
+func (l *LazyCollection[C]) Get() C {
+	l.once.Do(func() {
+		load := l.load
+		l.load = nil
+		l.c = load()
+	})
+	return l.c
+}
@@ -0,0 +1,42 @@
+package collection
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLazy(t *testing.T) {
+	var calls atomic.Int32
+	l := Lazy(func() *MockOrderedCollection[int] {
+		calls.Add(1)
+		return NewMockOrderedCollection([]int{1, 2, 3})
+	})
+	if calls.Load() != 0 {
+		t.Fatalf("Lazy() called the loader before Get()")
+	}
+
+	var wg sync.WaitGroup
+	results := make([]*MockOrderedCollection[int], 16)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = l.Get()
+		}()
+	}
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Errorf("Get() called the loader %v times, want %v", calls.Load(), 1)
+	}
+	for _, r := range results {
+		if r != results[0] {
+			t.Fatalf("Get() returned different collections")
+		}
+	}
+	if !slices.Equal(results[0].items, []int{1, 2, 3}) {
+		t.Errorf("Get() = %v, want %v", results[0].items, []int{1, 2, 3})
+	}
+}
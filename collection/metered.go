@@ -0,0 +1,182 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package collection
+
+import (
+	"fmt"
+	"iter"
+	"sync/atomic"
+)
+
+// Op identifies an operation performed on a Metered collection.
+type Op string
+
+const (
+	OpAdd     Op = "add"
+	OpRemove  Op = "remove"
+	OpClear   Op = "clear"
+	OpIterate Op = "iterate"
+)
+
+// Stats is a snapshot of the operations performed on a Metered collection.
+type Stats struct {
+	Adds       int64 // number of elements added
+	Removes    int64 // number of elements removed, including by Clear
+	Clears     int64 // number of calls to Clear
+	Iterations int64 // number of iterations started over the collection
+	MaxLength  int64 // maximum length reached by the collection
+}
+
+// Metered wraps a mutable collection and records the operations performed through it,
+// so that long-running services can expose collection behavior through expvar, Prometheus
+// or any other metrics system. Counters are updated atomically, Stats can be read from
+// another goroutine while the collection is in use, but the wrapped collection itself
+// is no more safe for concurrent use than it was before.
+//
+// Operations performed directly on the wrapped collection are not recorded.
+type Metered[T any] struct {
+	c          MutableCollection[T]
+	hook       func(op Op, length int)
+	adds       atomic.Int64
+	removes    atomic.Int64
+	clears     atomic.Int64
+	iterations atomic.Int64
+	maxLength  atomic.Int64
+}
+
+// NewMetered returns a metered view of the collection. The optional hook is called
+// after every recorded operation with the operation and the resulting length.
+//
+// example usage:
+//
+//	queue := NewMetered[int](list.NewComparableList[int](), nil)
+//	expvar.Publish("queue", expvar.Func(func() any { return queue.Stats() }))
+//	queue.Add(1)
+//	queue.Stats()
+//
+// output:
+//
+//	{Adds:1 Removes:0 Clears:0 Iterations:0 MaxLength:1}
+func NewMetered[T any](c MutableCollection[T], hook func(op Op, length int)) *Metered[T] {
+	m := &Metered[T]{c: c, hook: hook}
+	m.maxLength.Store(int64(c.Length()))
+	return m
+}
+
+// The following methods implement
+// the Collection interface.
+
+// Add adds a value to the collection.
+func (m *Metered[T]) Add(v T) {
+	m.c.Add(v)
+	m.adds.Add(1)
+	m.record(OpAdd)
+}
+
+// Length returns the length of the collection.
+func (m *Metered[T]) Length() int {
+	return m.c.Length()
+}
+
+// New returns a new collection constructed by the wrapped collection.
+// The new collection is not metered.
+func (m *Metered[T]) New(s ...[]T) Collection[T] {
+	return m.c.New(s...)
+}
+
+// Random returns a random element of the collection.
+func (m *Metered[T]) Random() T {
+	return m.c.Random()
+}
+
+// Values returns an iterator over the values of the collection.
+func (m *Metered[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		m.iterations.Add(1)
+		m.record(OpIterate)
+		for v := range m.c.Values() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// The following methods implement
+// the MutableCollection interface.
+
+// AddAll adds all the given values to the collection.
+func (m *Metered[T]) AddAll(v ...T) {
+	m.c.AddAll(v...)
+	m.adds.Add(int64(len(v)))
+	m.record(OpAdd)
+}
+
+// Clear removes all elements from the collection.
+func (m *Metered[T]) Clear() {
+	n := m.c.Length()
+	m.c.Clear()
+	m.removes.Add(int64(n))
+	m.clears.Add(1)
+	m.record(OpClear)
+}
+
+// Remove removes a value from the collection and returns true if it was present.
+func (m *Metered[T]) Remove(v T) bool {
+	ok := m.c.Remove(v)
+	if ok {
+		m.removes.Add(1)
+		m.record(OpRemove)
+	}
+	return ok
+}
+
+// RemoveWhere removes all elements that satisfy the predicate
+// and returns the number of elements removed.
+func (m *Metered[T]) RemoveWhere(f func(T) bool) int {
+	n := m.c.RemoveWhere(f)
+	if n > 0 {
+		m.removes.Add(int64(n))
+		m.record(OpRemove)
+	}
+	return n
+}
+
+// The following methods are specific to the Metered type.
+
+// Stats returns a snapshot of the recorded operations.
+func (m *Metered[T]) Stats() Stats {
+	return Stats{
+		Adds:       m.adds.Load(),
+		Removes:    m.removes.Load(),
+		Clears:     m.clears.Load(),
+		Iterations: m.iterations.Load(),
+		MaxLength:  m.maxLength.Load(),
+	}
+}
+
+// Unwrap returns the wrapped collection.
+func (m *Metered[T]) Unwrap() MutableCollection[T] {
+	return m.c
+}
+
+// String implements the Stringer interface.
+func (m *Metered[T]) String() string {
+	return fmt.Sprint(m.c)
+}
+
+// record updates the maximum length and calls the hook.
+func (m *Metered[T]) record(op Op) {
+	n := m.c.Length()
+	for {
+		cur := m.maxLength.Load()
+		if int64(n) <= cur || m.maxLength.CompareAndSwap(cur, int64(n)) {
+			break
+		}
+	}
+	if m.hook != nil {
+		m.hook(op, n)
+	}
+}
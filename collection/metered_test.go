@@ -0,0 +1,63 @@
+package collection
+
+import (
+	"slices"
+	"testing"
+)
+
+// mockMutableCollection extends MockCollection with the MutableCollection methods.
+type mockMutableCollection[T comparable] struct {
+	MockCollection[T]
+}
+
+func (m *mockMutableCollection[T]) AddAll(v ...T) { m.items = append(m.items, v...) }
+func (m *mockMutableCollection[T]) Clear()        { m.items = nil }
+func (m *mockMutableCollection[T]) Remove(v T) bool {
+	i := slices.Index(m.items, v)
+	if i == -1 {
+		return false
+	}
+	m.items = slices.Delete(m.items, i, i+1)
+	return true
+}
+func (m *mockMutableCollection[T]) RemoveWhere(f func(T) bool) int {
+	n := len(m.items)
+	m.items = slices.DeleteFunc(m.items, f)
+	return n - len(m.items)
+}
+
+func TestMetered(t *testing.T) {
+	var ops []Op
+	m := NewMetered[int](&mockMutableCollection[int]{MockCollection[int]{items: []int{1}}}, func(op Op, length int) {
+		ops = append(ops, op)
+	})
+	m.Add(2)
+	m.AddAll(3, 4, 5)
+	m.Remove(9)
+	m.Remove(1)
+	m.RemoveWhere(func(i int) bool { return i > 3 })
+	for range m.Values() {
+		break
+	}
+	m.Clear()
+
+	want := Stats{Adds: 4, Removes: 5, Clears: 1, Iterations: 1, MaxLength: 5}
+	if got := m.Stats(); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+	wantOps := []Op{OpAdd, OpAdd, OpRemove, OpRemove, OpIterate, OpClear}
+	if !slices.Equal(ops, wantOps) {
+		t.Errorf("hook received %v, want %v", ops, wantOps)
+	}
+}
+
+func TestMeteredWithCollectionFunctions(t *testing.T) {
+	m := NewMetered[int](&mockMutableCollection[int]{MockCollection[int]{items: []int{1, 2, 3, 4}}}, nil)
+	got := Filter(m, func(i int) bool { return i%2 == 0 }).(*MockCollection[int]).items
+	if !slices.Equal(got, []int{2, 4}) {
+		t.Errorf("Filter() = %v, want %v", got, []int{2, 4})
+	}
+	if m.Stats().Iterations != 1 {
+		t.Errorf("Stats().Iterations = %v, want %v", m.Stats().Iterations, 1)
+	}
+}
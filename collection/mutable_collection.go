@@ -0,0 +1,99 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package collection
+
+// MutableCollection is a generic interface for collections that can be
+// modified in place. It is implemented by ComparableSequence, ComparableList and Set so that
+// application code can accept any mutable collection polymorphically. Sequences and lists
+// of other types implement RemovableCollection, and are adapted with WithRemove or
+// WithRemoveFunc.
+type MutableCollection[T any] interface {
+	RemovableCollection[T]
+	Remove(v T) bool
+}
+
+// RemovableCollection is a generic interface for collections that can be modified
+// in place, but cannot remove a given value since their elements are not necessarily
+// comparable. It is implemented by Sequence, List and HistorySequence.
+type RemovableCollection[T any] interface {
+	Collection[T]
+	AddAll(v ...T)
+	Clear()
+	RemoveWhere(f func(T) bool) int
+}
+
+// WithRemove returns a MutableCollection view of a collection of comparable values,
+// whose Remove method removes the first element equal to the value with ==.
+//
+// example usage:
+//
+//	c := WithRemove[int](NewSequence([]int{1,2,1}))
+//	c.Remove(1)
+//
+// output:
+//
+//	true, c: [2,1]
+func WithRemove[T comparable](c RemovableCollection[T]) MutableCollection[T] {
+	return WithRemoveFunc(c, func(a, b T) bool { return a == b })
+}
+
+// WithRemoveFunc returns a MutableCollection view of the collection, whose Remove
+// method removes the first element equal to the value as reported by the equality
+// function, so that collections of any type can be used where a MutableCollection
+// is expected. The other methods are those of the collection.
+//
+// example usage:
+//
+//	c := WithRemoveFunc[[]int](NewSequence([][]int{{1},{2}}), slices.Equal[[]int])
+//	c.Remove([]int{2})
+//
+// output:
+//
+//	true, c: [[1]]
+func WithRemoveFunc[T any](c RemovableCollection[T], equal func(a, b T) bool) MutableCollection[T] {
+	return &removeFunc[T]{RemovableCollection: c, equal: equal}
+}
+
+// removeFunc adapts a RemovableCollection to the MutableCollection interface.
+type removeFunc[T any] struct {
+	RemovableCollection[T]
+	equal func(a, b T) bool
+}
+
+// Remove removes the first element equal to the value and returns true if one was removed.
+func (r *removeFunc[T]) Remove(v T) bool {
+	removed := false
+	r.RemoveWhere(func(e T) bool {
+		if removed || !r.equal(e, v) {
+			return false
+		}
+		removed = true
+		return true
+	})
+	return removed
+}
+
+// TransferWhere moves the elements of src that satisfy the predicate to dst in a single
+// pass, removing them from src and adding them to dst in iteration order, and returns the
+// number of elements moved. For example, to promote items from a pending List to an active Set.
+//
+// example usage:
+//
+//	pending := NewComparableList([]int{1,2,3,4})
+//	active := NewSet([]int{})
+//	TransferWhere(pending, active, func(i int) bool { return i%2 == 0 })
+//
+// output:
+//
+//	2, pending: [1,3], active: {2,4}
+func TransferWhere[T any](src MutableCollection[T], dst Collection[T], f func(T) bool) int {
+	return src.RemoveWhere(func(v T) bool {
+		if !f(v) {
+			return false
+		}
+		dst.Add(v)
+		return true
+	})
+}
@@ -0,0 +1,60 @@
+package collection
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTransferWhere(t *testing.T) {
+	src := &mockMutableCollection[int]{MockCollection[int]{items: []int{1, 2, 3, 4, 5, 6}}}
+	dst := NewMockCollection([]int{0})
+	calls := 0
+	n := TransferWhere(src, dst, func(i int) bool {
+		calls++
+		return i%2 == 0
+	})
+	if n != 3 {
+		t.Errorf("TransferWhere() = %v, want %v", n, 3)
+	}
+	if !slices.Equal(src.items, []int{1, 3, 5}) {
+		t.Errorf("TransferWhere() src = %v, want %v", src.items, []int{1, 3, 5})
+	}
+	if !slices.Equal(dst.items, []int{0, 2, 4, 6}) {
+		t.Errorf("TransferWhere() dst = %v, want %v", dst.items, []int{0, 2, 4, 6})
+	}
+	if calls != 6 {
+		t.Errorf("TransferWhere() called predicate %v times, want %v", calls, 6)
+	}
+	if n := TransferWhere(src, dst, func(i int) bool { return i > 10 }); n != 0 || len(dst.items) != 4 {
+		t.Errorf("TransferWhere() = %v, %v, want %v, %v", n, dst.items, 0, []int{0, 2, 4, 6})
+	}
+}
+
+func TestWithRemoveFunc(t *testing.T) {
+	src := NewMockCollection([][]int{{1}, {2}, {1}})
+	c := WithRemoveFunc[[]int](&removableMock[[]int]{src}, slices.Equal[[]int])
+	if !c.Remove([]int{1}) || c.Remove([]int{3}) {
+		t.Errorf("Remove() did not report the removals")
+	}
+	if got := slices.Collect(c.Values()); len(got) != 2 || got[0][0] != 2 || got[1][0] != 1 {
+		t.Errorf("Remove() = %v, want %v", got, [][]int{{2}, {1}})
+	}
+
+	ints := WithRemove[int](&removableMock[int]{NewMockCollection([]int{1, 2, 1})})
+	if !ints.Remove(1) || !slices.Equal(slices.Collect(ints.Values()), []int{2, 1}) {
+		t.Errorf("Remove() = %v, want %v", slices.Collect(ints.Values()), []int{2, 1})
+	}
+}
+
+// removableMock implements RemovableCollection for values of any type.
+type removableMock[T any] struct {
+	*MockCollection[T]
+}
+
+func (m *removableMock[T]) AddAll(v ...T) { m.items = append(m.items, v...) }
+func (m *removableMock[T]) Clear()        { m.items = nil }
+func (m *removableMock[T]) RemoveWhere(f func(T) bool) int {
+	n := len(m.items)
+	m.items = slices.DeleteFunc(m.items, f)
+	return n - len(m.items)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package collection
+
+import "fmt"
+
+// Option holds either a value, in which case it is defined, or nothing. It allows
+// functions returning optional results to be composed into pipelines, whose results
+// are flattened with CollectDefined or FirstDefined.
+// Defined options are built with Some, the zero value is an undefined option.
+type Option[T any] struct {
+	value   T
+	defined bool
+}
+
+// Some returns a defined option holding the value.
+func Some[T any](v T) Option[T] {
+	return Option[T]{value: v, defined: true}
+}
+
+// Get returns the value of the option and true if it is defined,
+// or the zero value and false otherwise.
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.defined
+}
+
+// IsDefined returns true if the option holds a value.
+func (o Option[T]) IsDefined() bool {
+	return o.defined
+}
+
+// OrElse returns the value of the option if it is defined, or def otherwise.
+func (o Option[T]) OrElse(def T) T {
+	if !o.defined {
+		return def
+	}
+	return o.value
+}
+
+// String implements the Stringer interface.
+func (o Option[T]) String() string {
+	if !o.defined {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.value)
+}
+
+// CollectDefined adds the values of the defined options to the destination
+// collection dst, which is then returned, skipping the undefined ones.
+//
+// example usage:
+//
+//	c := NewSequence([]Option[int]{Some(1), {}, Some(3)})
+//	CollectDefined(c, NewList[int]())
+//
+// output:
+//
+//	List(int) [1,3]
+func CollectDefined[T any, C Collection[T]](s Collection[Option[T]], dst C) C {
+	for o := range s.Values() {
+		if o.defined {
+			dst.Add(o.value)
+		}
+	}
+	return dst
+}
+
+// FirstDefined returns the first defined option of the collection,
+// or an undefined option if there is none.
+//
+// example usage:
+//
+//	c := NewSequence([]Option[int]{{}, Some(2), Some(3)})
+//	FirstDefined(c)
+//
+// output:
+//
+//	Some(2)
+func FirstDefined[T any](s Collection[Option[T]]) Option[T] {
+	for o := range s.Values() {
+		if o.defined {
+			return o
+		}
+	}
+	return Option[T]{}
+}
@@ -0,0 +1,65 @@
+package collection
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestOption(t *testing.T) {
+	var none Option[int]
+	if v, ok := Some(1).Get(); v != 1 || !ok {
+		t.Errorf("Get() = %v, %v, want %v, %v", v, ok, 1, true)
+	}
+	if v, ok := none.Get(); v != 0 || ok {
+		t.Errorf("Get() = %v, %v, want %v, %v", v, ok, 0, false)
+	}
+	if got := none.OrElse(5); got != 5 {
+		t.Errorf("OrElse() = %v, want %v", got, 5)
+	}
+	if got := Some(1).OrElse(5); got != 1 {
+		t.Errorf("OrElse() = %v, want %v", got, 1)
+	}
+	if none.IsDefined() || none.String() != "None" || Some(2).String() != "Some(2)" {
+		t.Errorf("String() = %v, %v, want %v, %v", none, Some(2), "None", "Some(2)")
+	}
+}
+
+func TestCollectDefined(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []Option[int]
+		want  []int
+	}{
+		{name: "mixed", input: []Option[int]{Some(1), {}, Some(0), {}}, want: []int{1, 0}},
+		{name: "all undefined", input: []Option[int]{{}, {}}, want: []int{}},
+		{name: "empty", input: []Option[int]{}, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CollectDefined(NewMockCollection(tt.input), NewMockCollection([]int{})).items
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("CollectDefined() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFirstDefined(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []Option[string]
+		want  Option[string]
+	}{
+		{name: "first is defined", input: []Option[string]{Some("a"), Some("b")}, want: Some("a")},
+		{name: "skips undefined", input: []Option[string]{{}, Some(""), Some("b")}, want: Some("")},
+		{name: "none defined", input: []Option[string]{{}}, want: Option[string]{}},
+		{name: "empty", input: []Option[string]{}, want: Option[string]{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FirstDefined(NewMockCollection(tt.input)); got != tt.want {
+				t.Errorf("FirstDefined() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -6,7 +6,11 @@
 
 package collection
 
-import "math/rand"
+import (
+	"container/heap"
+	"iter"
+	"math/rand"
+)
 
 // Corresponds tests whether every element of this sequence relates to the corresponding
 // element of another sequence by satisfying a test predicate.
@@ -114,6 +118,31 @@ func Find[T any](s OrderedCollection[T], f func(T) bool) (index int, value T) {
 	return -1, *new(T)
 }
 
+// FindAll returns the indices and values of all elements that satisfy a predicate.
+// The indices are returned as a slice and the values as a new collection, both in
+// the same order, so that values.At(i) is found at index indices[i] of the original collection.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,3,4,5,6})
+//	FindAll(c, func(i int) bool {
+//	  return i % 2 == 0
+//	})
+//
+// output
+//
+//	[1,3,5], [2,4,6]
+func FindAll[T any](s OrderedCollection[T], f func(T) bool) (indices []int, values OrderedCollection[T]) {
+	values = s.NewOrdered()
+	for i, v := range s.All() {
+		if f(v) {
+			indices = append(indices, i)
+			values.Add(v)
+		}
+	}
+	return indices, values
+}
+
 // FindLast returns the index and value of the last element
 // that satisfies a predicate, otherwise returns -1 and the zero value.
 //
@@ -152,6 +181,24 @@ func Head[T any](s OrderedCollection[T]) (T, error) {
 	return s.At(0), nil
 }
 
+// HeadOr returns the first element in a collection,
+// or the default value if the collection is empty.
+//
+// example usage:
+//
+//	c := NewSequence([]string{})
+//	HeadOr(c, "Z")
+//
+// output:
+//
+//	"Z"
+func HeadOr[T any](s OrderedCollection[T], def T) T {
+	if s.Length() == 0 {
+		return def
+	}
+	return s.At(0)
+}
+
 // Init returns a collection containing all elements excluding the last one.
 //
 // example usage:
@@ -187,6 +234,272 @@ func Last[T any](s OrderedCollection[T]) (T, error) {
 	return s.At(s.Length() - 1), nil
 }
 
+// LastOr returns the last element in a collection,
+// or the default value if the collection is empty.
+//
+// example usage:
+//
+//	c := NewSequence([]string{"A","B","C"})
+//	LastOr(c, "Z")
+//
+// output:
+//
+//	"C"
+func LastOr[T any](s OrderedCollection[T], def T) T {
+	if s.Length() == 0 {
+		return def
+	}
+	return s.At(s.Length() - 1)
+}
+
+// MergeSorted merges two collections that are already sorted according to the
+// less function into a new sorted collection in linear time. The merge is stable,
+// when elements are equal the ones from s1 come first.
+//
+// example usage:
+//
+//	c1 := NewSequence([]int{1,4,7})
+//	c2 := NewSequence([]int{2,3,8})
+//	MergeSorted(c1, c2, func(a, b int) bool { return a < b })
+//
+// output:
+//
+//	[1,2,3,4,7,8]
+func MergeSorted[T any](s1, s2 OrderedCollection[T], less func(T, T) bool) OrderedCollection[T] {
+	return MergeKSorted(less, s1, s2)
+}
+
+// MergeKSorted merges any number of collections that are already sorted according
+// to the less function into a new sorted collection, using a heap to select the next
+// element in O(log k) time. The merge is stable, when elements are equal the ones from
+// earlier collections come first. The result is constructed using the first collection,
+// if no collections are provided it returns nil.
+//
+// example usage:
+//
+//	c1 := NewSequence([]int{1,4})
+//	c2 := NewSequence([]int{2,5})
+//	c3 := NewSequence([]int{0,3})
+//	MergeKSorted(func(a, b int) bool { return a < b }, c1, c2, c3)
+//
+// output:
+//
+//	[0,1,2,3,4,5]
+func MergeKSorted[T any](less func(T, T) bool, s ...OrderedCollection[T]) OrderedCollection[T] {
+	if len(s) == 0 {
+		return nil
+	}
+	result := s[0].NewOrdered()
+	h := &mergeHeap[T]{less: less}
+	for i, c := range s {
+		next, stop := iter.Pull(c.Values())
+		defer stop()
+		if v, ok := next(); ok {
+			h.items = append(h.items, mergeItem[T]{value: v, source: i, next: next})
+		}
+	}
+	heap.Init(h)
+	for h.Len() > 0 {
+		item := &h.items[0]
+		result.Add(item.value)
+		if v, ok := item.next(); ok {
+			item.value = v
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return result
+}
+
+// mergeItem is the head element of one of the collections being merged.
+type mergeItem[T any] struct {
+	value  T
+	source int
+	next   func() (T, bool)
+}
+
+// mergeHeap implements heap.Interface over the head elements of the merged
+// collections, ordered by value then by source collection for stability.
+type mergeHeap[T any] struct {
+	items []mergeItem[T]
+	less  func(T, T) bool
+}
+
+func (h *mergeHeap[T]) Len() int { return len(h.items) }
+
+func (h *mergeHeap[T]) Less(i, j int) bool {
+	a, b := h.items[i], h.items[j]
+	if h.less(a.value, b.value) {
+		return true
+	}
+	if h.less(b.value, a.value) {
+		return false
+	}
+	return a.source < b.source
+}
+
+func (h *mergeHeap[T]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *mergeHeap[T]) Push(x any) { h.items = append(h.items, x.(mergeItem[T])) }
+
+func (h *mergeHeap[T]) Pop() any {
+	n := len(h.items)
+	item := h.items[n-1]
+	h.items = h.items[:n-1]
+	return item
+}
+
+// MergeDistinctSorted merges two collections that are already sorted according to the less
+// function into a new sorted collection without duplicates, in a single linear pass.
+// Two elements are duplicates when neither is less than the other, in which case the first
+// one encountered is kept, preferring elements of s1. Duplicates within s1 or s2 are also
+// dropped, so merging into a sorted unique list keeps it sorted and unique.
+//
+// example usage:
+//
+//	c1 := NewSequence([]int{1,3,5,7})
+//	c2 := NewSequence([]int{2,3,3,8})
+//	MergeDistinctSorted(c1, c2, func(a, b int) bool { return a < b })
+//
+// output:
+//
+//	[1,2,3,5,7,8]
+func MergeDistinctSorted[T any](s1, s2 OrderedCollection[T], less func(T, T) bool) OrderedCollection[T] {
+	result := s1.NewOrdered()
+	var last T
+	emit := func(v T) {
+		if result.Length() == 0 || less(last, v) {
+			result.Add(v)
+			last = v
+		}
+	}
+	next1, stop1 := iter.Pull(s1.Values())
+	defer stop1()
+	next2, stop2 := iter.Pull(s2.Values())
+	defer stop2()
+	a, ok1 := next1()
+	b, ok2 := next2()
+	for ok1 || ok2 {
+		if ok2 && (!ok1 || less(b, a)) {
+			emit(b)
+			b, ok2 = next2()
+		} else {
+			emit(a)
+			a, ok1 = next1()
+		}
+	}
+	return result
+}
+
+// MismatchIndex returns the index of the first element of s1 that does not relate to the
+// corresponding element of s2 according to the "equality" function f. If one collection is
+// a prefix of the other, the length of the shorter one is returned. If the collections
+// correspond entirely, it returns -1.
+//
+// example usage:
+//
+//	c1 := NewSequence([]int{1,2,3,4})
+//	c2 := NewSequence([]int{1,2,5,4})
+//	MismatchIndex(c1, c2, func(a, b int) bool { return a == b })
+//
+// output:
+//
+//	2
+func MismatchIndex[T, K any](s1 OrderedCollection[T], s2 OrderedCollection[K], f func(T, K) bool) int {
+	next, stop := iter.Pull(s2.Values())
+	defer stop()
+	i := 0
+	for v := range s1.Values() {
+		u, ok := next()
+		if !ok || !f(v, u) {
+			return i
+		}
+		i++
+	}
+	if i != s2.Length() {
+		return i
+	}
+	return -1
+}
+
+// PageInfo describes a page of a paginated collection. Index is zero-based.
+type PageInfo struct {
+	Index      int
+	Size       int
+	TotalItems int
+	TotalPages int
+	HasPrev    bool
+	HasNext    bool
+}
+
+// Page returns the elements of the page at the given zero-based index, when the collection
+// is split into pages of the given size, along with a PageInfo describing the pagination.
+// A page index past the last page returns an empty collection.
+// It panics with an InvalidArgumentError if the index is negative or the size is not positive.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,3,4,5,6,7})
+//	Page(c, 1, 3)
+//
+// output:
+//
+//	[4,5,6], {Index:1 Size:3 TotalItems:7 TotalPages:3 HasPrev:true HasNext:true}
+func Page[T any](s OrderedCollection[T], index, size int) (OrderedCollection[T], PageInfo) {
+	if index < 0 || size <= 0 {
+		panic(InvalidArgumentError)
+	}
+	info := pageInfo(s.Length(), index, size)
+	start := min(index, info.TotalPages) * size
+	return s.Slice(min(start, s.Length()), min(start+size, s.Length())), info
+}
+
+// Chunks returns an iterator over the pages of the given size of the collection, along with
+// a PageInfo describing each page, so that the total number of items and pages is known
+// while processing the first chunk. The last chunk may hold fewer elements.
+// It panics with an InvalidArgumentError if the size is not positive.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,3,4,5})
+//	for info, chunk := range Chunks(c, 2) {
+//	  fmt.Println(info.Index+1, "/", info.TotalPages, chunk)
+//	}
+//
+// output:
+//
+//	1 / 3 [1,2]
+//	2 / 3 [3,4]
+//	3 / 3 [5]
+func Chunks[T any](s OrderedCollection[T], size int) iter.Seq2[PageInfo, OrderedCollection[T]] {
+	if size <= 0 {
+		panic(InvalidArgumentError)
+	}
+	return func(yield func(PageInfo, OrderedCollection[T]) bool) {
+		n := s.Length()
+		for start := 0; start < n; start += size {
+			info := pageInfo(n, start/size, size)
+			if !yield(info, s.Slice(start, min(start+size, n))) {
+				return
+			}
+		}
+	}
+}
+
+// pageInfo describes the page at the given index of a collection of n elements.
+func pageInfo(n, index, size int) PageInfo {
+	pages := (n + size - 1) / size
+	return PageInfo{
+		Index:      index,
+		Size:       size,
+		TotalItems: n,
+		TotalPages: pages,
+		HasPrev:    index > 0,
+		HasNext:    index+1 < pages,
+	}
+}
+
 // ReduceRight takes a collection of type T, a reducing function func(K, T) K,
 // and an initial value of type K as parameters. It applies the reducing
 // function to each element in reverse order and returns the resulting value K.
@@ -226,24 +539,44 @@ func Reverse[T any](s OrderedCollection[T]) OrderedCollection[T] {
 }
 
 // ReverseMap takes a collection of type T and a mapping function func(T) K,
-// applies the mapping function to each element in reverseand returns a collection of type K.
+// applies the mapping function to each element in reverse and returns a slice of type K.
 //
 // example usage:
 //
-//	names := NewCollection([]string{"Alice", "Bob", "Charlie"})
-//	Map(names, func(name string) int {
+//	names := NewSequence([]string{"Alice", "Bob", "Charlie"})
+//	ReverseMap(names, func(name string) int {
 //	  return len(name)
 //	})
 //
 // output:
 //
-//	[6, 3, 5]
-func ReverseMap[T, K any](s OrderedCollection[T], f func(T) K) OrderedCollection[K] {
-	r := s.NewOrdered().(OrderedCollection[K])
+//	[7,3,5]
+func ReverseMap[T, K any](s OrderedCollection[T], f func(T) K) []K {
+	k := make([]K, 0, s.Length())
+	for _, v := range s.Backward() {
+		k = append(k, f(v))
+	}
+	return k
+}
+
+// ReverseMapInto is similar to ReverseMap but adds the mapped values
+// to the destination collection dst, which is then returned.
+//
+// example usage:
+//
+//	names := NewSequence([]string{"Alice", "Bob", "Charlie"})
+//	ReverseMapInto(names, func(name string) int {
+//	  return len(name)
+//	}, NewList[int]())
+//
+// output:
+//
+//	List(int) [7,3,5]
+func ReverseMapInto[T, K any, C Collection[K]](s OrderedCollection[T], f func(T) K, dst C) C {
 	for _, v := range s.Backward() {
-		r.Add(f(v))
+		dst.Add(f(v))
 	}
-	return r
+	return dst
 }
 
 // SplitAt returns two new sequences containing the first n elements and the rest of the elements.
@@ -260,6 +593,134 @@ func SplitAt[T any](s OrderedCollection[T], n int) (OrderedCollection[T], Ordere
 	return s.Slice(0, n), s.Slice(n, s.Length())
 }
 
+// SliceSafe is similar to calling s.Slice(start, end) but returns an IndexOutOfBoundsError
+// instead of panicking when the indices are out of range, which allows indices coming from
+// user input to be used without prior validation.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,3})
+//	SliceSafe(c, 1, 3)
+//	SliceSafe(c, 2, 5)
+//
+// output:
+//
+//	[2,3], nil
+//	nil, error 102: index out of bounds
+func SliceSafe[T any](s OrderedCollection[T], start, end int) (OrderedCollection[T], error) {
+	if start < 0 || end > s.Length() || start > end {
+		return nil, IndexOutOfBoundsError
+	}
+	return s.Slice(start, end), nil
+}
+
+// SliceStep returns a new collection containing every step-th element from the start
+// index up to the end index (exclusive), following Python's slicing rules: negative
+// indices count from the end of the collection, out of range indices are clamped,
+// and a negative step walks the collection backwards from start down to end.
+// Pass math.MinInt or math.MaxInt as start or end to slice to an edge of the collection.
+// It panics with an InvalidArgumentError if step is zero.
+//
+// example usage:
+//
+//	c := NewSequence([]int{0,1,2,3,4,5,6})
+//	SliceStep(c, 1, -1, 2)
+//	SliceStep(c, -1, math.MinInt, -3)
+//
+// output:
+//
+//	[1,3,5]
+//	[6,3,0]
+func SliceStep[T any](s OrderedCollection[T], start, end, step int) OrderedCollection[T] {
+	if step == 0 {
+		panic(InvalidArgumentError)
+	}
+	n := s.Length()
+	start, end = sliceIndex(start, n, step), sliceIndex(end, n, step)
+	result := s.NewOrdered()
+	if step > 0 {
+		for i, v := range s.All() {
+			if i >= end {
+				break
+			}
+			if i >= start && (i-start)%step == 0 {
+				result.Add(v)
+			}
+		}
+		return result
+	}
+	for i, v := range s.Backward() {
+		if i <= end {
+			break
+		}
+		if i <= start && (start-i)%step == 0 {
+			result.Add(v)
+		}
+	}
+	return result
+}
+
+// sliceIndex resolves a Python-style slice index against a collection of length n.
+func sliceIndex(i, n, step int) int {
+	if i < 0 {
+		i += n
+		if i < 0 {
+			if step < 0 {
+				return -1
+			}
+			return 0
+		}
+	} else if i >= n {
+		if step < 0 {
+			return n - 1
+		}
+		return n
+	}
+	return i
+}
+
+// SplitAtSafe is similar to SplitAt but returns an IndexOutOfBoundsError
+// instead of panicking when n is out of range.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,3,4,5,6})
+//	SplitAtSafe(c, 3)
+//	SplitAtSafe(c, 7)
+//
+// output:
+//
+//	[1,2,3], [4,5,6], nil
+//	nil, nil, error 102: index out of bounds
+func SplitAtSafe[T any](s OrderedCollection[T], n int) (OrderedCollection[T], OrderedCollection[T], error) {
+	if n < 0 || n > s.Length() {
+		return nil, nil, IndexOutOfBoundsError
+	}
+	left, right := SplitAt(s, n)
+	return left, right, nil
+}
+
+// SplitWhenKeyChanges splits the collection into runs of consecutive elements sharing the
+// same key, starting a new run every time the key returned by f changes. Unlike GroupBy,
+// elements with the same key that are not contiguous end up in different runs.
+// Each run is a collection constructed using s.NewOrdered().
+//
+// example usage:
+//
+//	c := NewSequence([]string{"ann:login", "ann:view", "bob:login", "ann:logout"})
+//	SplitWhenKeyChanges(c, func(e string) string { return strings.Split(e, ":")[0] })
+//
+// output:
+//
+//	[[ann:login ann:view] [bob:login] [ann:logout]]
+func SplitWhenKeyChanges[T any, K comparable](s OrderedCollection[T], f func(T) K) []OrderedCollection[T] {
+	var runs []OrderedCollection[T]
+	for _, run := range SplitWhenKeyChangesSeq(s, f) {
+		runs = append(runs, run)
+	}
+	return runs
+}
+
 // Tail returns a new sequence containing all elements excluding the first one.
 //
 // example usage:
@@ -357,12 +818,31 @@ func Shuffle[T any](s OrderedCollection[T]) OrderedCollection[T] {
 //
 //	true
 func StartsWith[T comparable](s1 OrderedCollection[T], s2 OrderedCollection[T]) bool {
+	return StartsWithFunc(s1, s2, func(a, b T) bool { return a == b })
+}
+
+// StartsWithFunc is similar to StartsWith but applies to non-comparable types,
+// and to collections of different types. It takes an "equality" function relating
+// the elements of s1 to the elements of s2.
+//
+// Example usage:
+//
+//	c1 := NewSequence([]User{{ID: 1}, {ID: 2}, {ID: 3}})
+//	c2 := NewSequence([]int{1, 2})
+//	StartsWithFunc(c1, c2, func(u User, id int) bool { return u.ID == id })
+//
+// Output:
+//
+//	true
+func StartsWithFunc[T, K any](s1 OrderedCollection[T], s2 OrderedCollection[K], f func(T, K) bool) bool {
 	if s1.Length() < s2.Length() {
 		return false
 	}
-
-	for i, v := range s2.All() {
-		if v != s1.At(i) {
+	next, stop := iter.Pull(s1.Values())
+	defer stop()
+	for v := range s2.Values() {
+		u, _ := next()
+		if !f(u, v) {
 			return false
 		}
 	}
@@ -382,17 +862,90 @@ func StartsWith[T comparable](s1 OrderedCollection[T], s2 OrderedCollection[T])
 //
 //	true
 func EndsWith[T comparable](s1 OrderedCollection[T], s2 OrderedCollection[T]) bool {
+	return EndsWithFunc(s1, s2, func(a, b T) bool { return a == b })
+}
+
+// EndsWithFunc is similar to EndsWith but applies to non-comparable types,
+// and to collections of different types. It takes an "equality" function relating
+// the elements of s1 to the elements of s2.
+//
+// Example usage:
+//
+//	c1 := NewSequence([]User{{ID: 1}, {ID: 2}, {ID: 3}})
+//	c2 := NewSequence([]int{2, 3})
+//	EndsWithFunc(c1, c2, func(u User, id int) bool { return u.ID == id })
+//
+// Output:
+//
+//	true
+func EndsWithFunc[T, K any](s1 OrderedCollection[T], s2 OrderedCollection[K], f func(T, K) bool) bool {
 	// If s2 is longer than s1, s1 cannot end with s2
 	if s1.Length() < s2.Length() {
 		return false
 	}
-
-	offset := s1.Length() - s2.Length()
-
-	for i, v := range s2.All() {
-		if s1.At(offset+i) != v {
+	next, stop := iter.Pull2(s1.Backward())
+	defer stop()
+	for _, v := range s2.Backward() {
+		_, u, _ := next()
+		if !f(u, v) {
 			return false
 		}
 	}
 	return true
 }
+
+// EqualsRotated returns true if the second collection (s2) is a cyclic rotation of the
+// first collection (s1), that is, if s2 equals s1 after moving some of its initial elements
+// to its end. This compares circular structures such as the vertices of a polygon or the
+// stops of a round trip, whose starting point is arbitrary.
+//
+// It searches for s2 in s1 followed by itself using the Knuth-Morris-Pratt algorithm,
+// which runs in linear time.
+//
+// Example usage:
+//
+//	c1 := NewSequence([]int{1, 2, 3, 4})
+//	c2 := NewSequence([]int{3, 4, 1, 2})
+//	EqualsRotated(c1, c2)
+//
+// Output:
+//
+//	true
+func EqualsRotated[T comparable](s1 OrderedCollection[T], s2 OrderedCollection[T]) bool {
+	n := s1.Length()
+	if n != s2.Length() {
+		return false
+	}
+	if n == 0 {
+		return true
+	}
+	text := AppendToSlice(s1, make([]T, 0, n))
+	pattern := AppendToSlice(s2, make([]T, 0, n))
+	// prefix[i] is the length of the longest proper prefix
+	// of pattern[:i+1] which is also a suffix of it.
+	prefix := make([]int, n)
+	for i, k := 1, 0; i < n; i++ {
+		for k > 0 && pattern[i] != pattern[k] {
+			k = prefix[k-1]
+		}
+		if pattern[i] == pattern[k] {
+			k++
+		}
+		prefix[i] = k
+	}
+	// match the pattern against the text doubled, without materializing it.
+	// a match must start within the first n elements, so 2n-1 elements suffice.
+	for i, k := 0, 0; i < 2*n-1; i++ {
+		v := text[i%n]
+		for k > 0 && v != pattern[k] {
+			k = prefix[k-1]
+		}
+		if v == pattern[k] {
+			k++
+		}
+		if k == n {
+			return true
+		}
+	}
+	return false
+}
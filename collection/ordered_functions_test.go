@@ -2,8 +2,10 @@ package collection
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 	"slices"
+	"strconv"
 	"testing"
 )
 
@@ -30,6 +32,31 @@ func TestCorresponds(t *testing.T) {
 	}
 }
 
+func TestMismatchIndex(t *testing.T) {
+	tests := []struct {
+		name string
+		A    []int
+		B    []int
+		want int
+	}{
+		{name: "equal", A: []int{1, 2, 3}, B: []int{1, 2, 3}, want: -1},
+		{name: "both empty", A: []int{}, B: []int{}, want: -1},
+		{name: "first element differs", A: []int{1, 2, 3}, B: []int{0, 2, 3}, want: 0},
+		{name: "middle element differs", A: []int{1, 2, 3, 4}, B: []int{1, 2, 5, 4}, want: 2},
+		{name: "first is shorter", A: []int{1, 2}, B: []int{1, 2, 3}, want: 2},
+		{name: "second is shorter", A: []int{1, 2, 3}, B: []int{1}, want: 1},
+		{name: "first is empty", A: []int{}, B: []int{1}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MismatchIndex(NewMockOrderedCollection(tt.A), NewMockOrderedCollection(tt.B), func(a, b int) bool { return a == b })
+			if got != tt.want {
+				t.Errorf("MismatchIndex() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestDrop(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -125,6 +152,48 @@ func TestFind(t *testing.T) {
 	}
 }
 
+func TestFindAll(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	tests := []struct {
+		name            string
+		input           []int
+		expectedIndices []int
+		expectedValues  []int
+	}{
+		{
+			name:            "empty slice",
+			input:           []int{},
+			expectedIndices: nil,
+			expectedValues:  nil,
+		},
+		{
+			name:            "values found",
+			input:           []int{1, 2, 3, 4, 5, 6},
+			expectedIndices: []int{1, 3, 5},
+			expectedValues:  []int{2, 4, 6},
+		},
+		{
+			name:            "values not found",
+			input:           []int{1, 3, 5},
+			expectedIndices: nil,
+			expectedValues:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indices, values := FindAll(NewMockOrderedCollection(tt.input), isEven)
+			if !slices.Equal(indices, tt.expectedIndices) {
+				t.Errorf("FindAll() indices = %v, want %v", indices, tt.expectedIndices)
+			}
+			if !slices.Equal(values.(*MockOrderedCollection[int]).items, tt.expectedValues) {
+				t.Errorf("FindAll() values = %v, want %v", values, tt.expectedValues)
+			}
+		})
+	}
+}
+
 func TestDropRight(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -438,13 +507,24 @@ func TestReverseMap(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			got := ReverseMap(NewMockOrderedCollection(tt.input), double)
-			if !slices.Equal(got.(*MockOrderedCollection[int]).items, tt.want) {
-				t.Errorf("ReverseMap() = %v, want %v", got, NewMockOrderedCollection(tt.want))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ReverseMap() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
+func TestReverseMapInto(t *testing.T) {
+	got := ReverseMapInto(
+		NewMockOrderedCollection([]int{1, 22, 333}),
+		func(n int) string { return strconv.Itoa(n) },
+		NewMockOrderedCollection[string](),
+	)
+	if !slices.Equal(got.items, []string{"333", "22", "1"}) {
+		t.Errorf("ReverseMapInto() = %v, want %v", got.items, []string{"333", "22", "1"})
+	}
+}
+
 func TestTail(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -707,3 +787,336 @@ func TestShuffleDistribution(t *testing.T) {
 		}
 	}
 }
+
+func TestMergeSorted(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	tests := []struct {
+		name string
+		a    []int
+		b    []int
+		want []int
+	}{
+		{name: "interleaved", a: []int{1, 4, 7}, b: []int{2, 3, 8}, want: []int{1, 2, 3, 4, 7, 8}},
+		{name: "empty first", a: []int{}, b: []int{1, 2}, want: []int{1, 2}},
+		{name: "empty second", a: []int{1, 2}, b: []int{}, want: []int{1, 2}},
+		{name: "both empty", a: []int{}, b: []int{}, want: nil},
+		{name: "duplicates", a: []int{1, 2, 2}, b: []int{2, 3}, want: []int{1, 2, 2, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MergeSorted(NewMockOrderedCollection(tt.a), NewMockOrderedCollection(tt.b), less)
+			if !slices.Equal(got.(*MockOrderedCollection[int]).items, tt.want) {
+				t.Errorf("MergeSorted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeKSorted(t *testing.T) {
+	type event struct {
+		at     int
+		source string
+	}
+	less := func(a, b event) bool { return a.at < b.at }
+	got := MergeKSorted(less,
+		NewMockOrderedCollection([]event{{1, "a"}, {4, "a"}}),
+		NewMockOrderedCollection([]event{}),
+		NewMockOrderedCollection([]event{{0, "c"}, {4, "c"}, {9, "c"}}),
+		NewMockOrderedCollection([]event{{1, "d"}, {5, "d"}}),
+	).(*MockOrderedCollection[event]).items
+	want := []event{{0, "c"}, {1, "a"}, {1, "d"}, {4, "a"}, {4, "c"}, {5, "d"}, {9, "c"}}
+	if !slices.Equal(got, want) {
+		t.Errorf("MergeKSorted() = %v, want %v", got, want)
+	}
+
+	if MergeKSorted[int](func(a, b int) bool { return a < b }) != nil {
+		t.Errorf("MergeKSorted() with no collections should return nil")
+	}
+}
+
+func TestMergeDistinctSorted(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	tests := []struct {
+		name string
+		a    []int
+		b    []int
+		want []int
+	}{
+		{name: "interleaved", a: []int{1, 3, 5, 7}, b: []int{2, 3, 3, 8}, want: []int{1, 2, 3, 5, 7, 8}},
+		{name: "duplicates within first", a: []int{1, 1, 2}, b: []int{3}, want: []int{1, 2, 3}},
+		{name: "identical", a: []int{1, 2}, b: []int{1, 2}, want: []int{1, 2}},
+		{name: "empty first", a: []int{}, b: []int{1, 1, 2}, want: []int{1, 2}},
+		{name: "both empty", a: []int{}, b: []int{}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MergeDistinctSorted(NewMockOrderedCollection(tt.a), NewMockOrderedCollection(tt.b), less)
+			if !slices.Equal(got.(*MockOrderedCollection[int]).items, tt.want) {
+				t.Errorf("MergeDistinctSorted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	type entry struct {
+		id     int
+		source string
+	}
+	got := MergeDistinctSorted(
+		NewMockOrderedCollection([]entry{{1, "a"}, {2, "a"}}),
+		NewMockOrderedCollection([]entry{{1, "b"}, {3, "b"}}),
+		func(x, y entry) bool { return x.id < y.id },
+	).(*MockOrderedCollection[entry]).items
+	if want := []entry{{1, "a"}, {2, "a"}, {3, "b"}}; !slices.Equal(got, want) {
+		t.Errorf("MergeDistinctSorted() = %v, want %v", got, want)
+	}
+}
+
+func TestPage(t *testing.T) {
+	c := NewMockOrderedCollection([]int{1, 2, 3, 4, 5, 6, 7})
+	tests := []struct {
+		name  string
+		index int
+		size  int
+		want  []int
+		info  PageInfo
+	}{
+		{name: "first page", index: 0, size: 3, want: []int{1, 2, 3}, info: PageInfo{Index: 0, Size: 3, TotalItems: 7, TotalPages: 3, HasNext: true}},
+		{name: "middle page", index: 1, size: 3, want: []int{4, 5, 6}, info: PageInfo{Index: 1, Size: 3, TotalItems: 7, TotalPages: 3, HasPrev: true, HasNext: true}},
+		{name: "last partial page", index: 2, size: 3, want: []int{7}, info: PageInfo{Index: 2, Size: 3, TotalItems: 7, TotalPages: 3, HasPrev: true}},
+		{name: "past the last page", index: 5, size: 3, want: []int{}, info: PageInfo{Index: 5, Size: 3, TotalItems: 7, TotalPages: 3, HasPrev: true}},
+		{name: "single page", index: 0, size: 10, want: []int{1, 2, 3, 4, 5, 6, 7}, info: PageInfo{Index: 0, Size: 10, TotalItems: 7, TotalPages: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, info := Page(c, tt.index, tt.size)
+			if got := page.(*MockOrderedCollection[int]).items; !slices.Equal(got, tt.want) {
+				t.Errorf("Page() = %v, want %v", got, tt.want)
+			}
+			if info != tt.info {
+				t.Errorf("Page() info = %+v, want %+v", info, tt.info)
+			}
+		})
+	}
+
+	defer func() {
+		if r := recover(); r != InvalidArgumentError {
+			t.Errorf("Page() panic = %v, want %v", r, InvalidArgumentError)
+		}
+	}()
+	Page(c, 0, 0)
+}
+
+func TestChunks(t *testing.T) {
+	c := NewMockOrderedCollection([]int{1, 2, 3, 4, 5})
+	var chunks [][]int
+	var infos []PageInfo
+	for info, chunk := range Chunks(c, 2) {
+		chunks = append(chunks, chunk.(*MockOrderedCollection[int]).items)
+		infos = append(infos, info)
+	}
+	want := [][]int{{1, 2}, {3, 4}, {5}}
+	if !slices.EqualFunc(chunks, want, slices.Equal) {
+		t.Errorf("Chunks() = %v, want %v", chunks, want)
+	}
+	for i, info := range infos {
+		if info.Index != i || info.TotalItems != 5 || info.TotalPages != 3 || info.HasNext != (i < 2) {
+			t.Errorf("Chunks() info[%v] = %+v", i, info)
+		}
+	}
+
+	count := 0
+	for range Chunks(c, 1) {
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("Chunks() yielded %v chunks after break, want %v", count, 1)
+	}
+	for range Chunks(NewMockOrderedCollection([]int{}), 3) {
+		t.Errorf("Chunks() yielded a chunk for an empty collection")
+	}
+}
+
+func TestHeadOrLastOr(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		head  int
+		last  int
+	}{
+		{name: "non-empty", input: []int{1, 2, 3}, head: 1, last: 3},
+		{name: "single element", input: []int{7}, head: 7, last: 7},
+		{name: "empty", input: []int{}, head: -1, last: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewMockOrderedCollection(tt.input)
+			if got := HeadOr(c, -1); got != tt.head {
+				t.Errorf("HeadOr() = %v, want %v", got, tt.head)
+			}
+			if got := LastOr(c, -1); got != tt.last {
+				t.Errorf("LastOr() = %v, want %v", got, tt.last)
+			}
+		})
+	}
+}
+
+func TestStartsWithFuncEndsWithFunc(t *testing.T) {
+	type user struct {
+		id   int
+		tags []string
+	}
+	byID := func(u user, id int) bool { return u.id == id }
+	users := NewMockOrderedCollection([]user{{1, nil}, {2, []string{"a"}}, {3, nil}})
+	tests := []struct {
+		name       string
+		ids        []int
+		startsWith bool
+		endsWith   bool
+	}{
+		{name: "prefix", ids: []int{1, 2}, startsWith: true, endsWith: false},
+		{name: "suffix", ids: []int{2, 3}, startsWith: false, endsWith: true},
+		{name: "whole", ids: []int{1, 2, 3}, startsWith: true, endsWith: true},
+		{name: "empty", ids: []int{}, startsWith: true, endsWith: true},
+		{name: "longer", ids: []int{1, 2, 3, 4}, startsWith: false, endsWith: false},
+		{name: "no match", ids: []int{5}, startsWith: false, endsWith: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids := NewMockOrderedCollection(tt.ids)
+			if got := StartsWithFunc(users, ids, byID); got != tt.startsWith {
+				t.Errorf("StartsWithFunc() = %v, want %v", got, tt.startsWith)
+			}
+			if got := EndsWithFunc(users, ids, byID); got != tt.endsWith {
+				t.Errorf("EndsWithFunc() = %v, want %v", got, tt.endsWith)
+			}
+		})
+	}
+}
+
+func TestSplitWhenKeyChanges(t *testing.T) {
+	parity := func(i int) bool { return i%2 == 0 }
+	tests := []struct {
+		name  string
+		input []int
+		want  [][]int
+	}{
+		{name: "alternating runs", input: []int{1, 3, 2, 4, 5}, want: [][]int{{1, 3}, {2, 4}, {5}}},
+		{name: "single run", input: []int{2, 4, 6}, want: [][]int{{2, 4, 6}}},
+		{name: "every element", input: []int{1, 2, 3}, want: [][]int{{1}, {2}, {3}}},
+		{name: "empty", input: []int{}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got [][]int
+			for _, run := range SplitWhenKeyChanges(NewMockOrderedCollection(tt.input), parity) {
+				got = append(got, run.(*MockOrderedCollection[int]).items)
+			}
+			if !slices.EqualFunc(got, tt.want, slices.Equal) {
+				t.Errorf("SplitWhenKeyChanges() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSliceStep(t *testing.T) {
+	input := []int{0, 1, 2, 3, 4, 5, 6}
+	tests := []struct {
+		name             string
+		start, end, step int
+		want             []int
+	}{
+		{name: "plain slice", start: 2, end: 5, step: 1, want: []int{2, 3, 4}},
+		{name: "stride", start: 1, end: -1, step: 2, want: []int{1, 3, 5}},
+		{name: "negative start", start: -3, end: math.MaxInt, step: 1, want: []int{4, 5, 6}},
+		{name: "whole collection reversed", start: math.MaxInt, end: math.MinInt, step: -1, want: []int{6, 5, 4, 3, 2, 1, 0}},
+		{name: "reverse stride", start: -1, end: math.MinInt, step: -3, want: []int{6, 3, 0}},
+		{name: "reverse with end", start: 5, end: 1, step: -2, want: []int{5, 3}},
+		{name: "clamped indices", start: -100, end: 100, step: 3, want: []int{0, 3, 6}},
+		{name: "empty when start after end", start: 5, end: 2, step: 1, want: []int{}},
+		{name: "empty when reversed start before end", start: 2, end: 5, step: -1, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SliceStep(NewMockOrderedCollection(input), tt.start, tt.end, tt.step).(*MockOrderedCollection[int]).items
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("SliceStep() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	defer func() {
+		if r := recover(); r != InvalidArgumentError {
+			t.Errorf("SliceStep() panic = %v, want %v", r, InvalidArgumentError)
+		}
+	}()
+	SliceStep(NewMockOrderedCollection(input), 0, 1, 0)
+}
+
+func TestSliceSafe(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end int
+		want       []int
+		err        error
+	}{
+		{name: "valid", start: 1, end: 3, want: []int{2, 3}},
+		{name: "empty", start: 3, end: 3, want: []int{}},
+		{name: "negative start", start: -1, end: 2, err: IndexOutOfBoundsError},
+		{name: "end past length", start: 0, end: 4, err: IndexOutOfBoundsError},
+		{name: "start after end", start: 2, end: 1, err: IndexOutOfBoundsError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SliceSafe(NewMockOrderedCollection([]int{1, 2, 3}), tt.start, tt.end)
+			if err != tt.err {
+				t.Fatalf("SliceSafe() error = %v, want %v", err, tt.err)
+			}
+			if err == nil && !slices.Equal(got.(*MockOrderedCollection[int]).items, tt.want) {
+				t.Errorf("SliceSafe() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitAtSafe(t *testing.T) {
+	c := NewMockOrderedCollection([]int{1, 2, 3})
+	left, right, err := SplitAtSafe(c, 1)
+	if err != nil {
+		t.Fatalf("SplitAtSafe() error = %v", err)
+	}
+	if !slices.Equal(left.(*MockOrderedCollection[int]).items, []int{1}) || !slices.Equal(right.(*MockOrderedCollection[int]).items, []int{2, 3}) {
+		t.Errorf("SplitAtSafe() = %v, %v, want %v, %v", left, right, []int{1}, []int{2, 3})
+	}
+	for _, n := range []int{-1, 4} {
+		if _, _, err := SplitAtSafe(c, n); err != IndexOutOfBoundsError {
+			t.Errorf("SplitAtSafe(%v) error = %v, want %v", n, err, IndexOutOfBoundsError)
+		}
+	}
+}
+
+func TestEqualsRotated(t *testing.T) {
+	tests := []struct {
+		name    string
+		A       []int
+		B       []int
+		rotated bool
+	}{
+		{name: "identical", A: []int{1, 2, 3}, B: []int{1, 2, 3}, rotated: true},
+		{name: "rotated", A: []int{1, 2, 3, 4}, B: []int{3, 4, 1, 2}, rotated: true},
+		{name: "rotated by one", A: []int{1, 2, 3, 4}, B: []int{4, 1, 2, 3}, rotated: true},
+		{name: "repeated elements", A: []int{1, 1, 2, 1, 1, 3}, B: []int{1, 3, 1, 1, 2, 1}, rotated: true},
+		{name: "reversed", A: []int{1, 2, 3}, B: []int{3, 2, 1}, rotated: false},
+		{name: "same elements", A: []int{1, 1, 2, 2}, B: []int{1, 2, 1, 2}, rotated: false},
+		{name: "different lengths", A: []int{1, 2, 1, 2}, B: []int{1, 2}, rotated: false},
+		{name: "both empty", A: []int{}, B: []int{}, rotated: true},
+		{name: "single element", A: []int{7}, B: []int{8}, rotated: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EqualsRotated(NewMockOrderedCollection(tt.A), NewMockOrderedCollection(tt.B))
+			if got != tt.rotated {
+				t.Errorf("EqualsRotated() = %v, want %v", got, tt.rotated)
+			}
+		})
+	}
+}
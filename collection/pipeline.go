@@ -0,0 +1,127 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// pipeline.go defines helpers to compose collection transformations.
+// A Transform is a reusable step that can be defined as a value
+// and applied to any collection, or combined with other steps.
+// Stages run by Pipe and Compose are logged when tracing is enabled,
+// and steps can be named to tell them apart in the logs.
+
+package collection
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+)
+
+// Transform is a transformation step from a collection to a new collection.
+type Transform[T any] func(Collection[T]) Collection[T]
+
+// Pipe applies the transforms to a collection from left to right
+// and returns the resulting collection.
+//
+// example usage:
+//
+//	evens := func(c Collection[int]) Collection[int] {
+//	  return Filter(c, func(i int) bool { return i%2 == 0 })
+//	}
+//	distinct := func(c Collection[int]) Collection[int] {
+//	  return Distinct(c, func(a, b int) bool { return a == b })
+//	}
+//	Pipe(NewSequence([]int{1,2,2,3,4,4}), evens, distinct)
+//
+// output:
+//
+//	[2,4]
+func Pipe[T any](s Collection[T], fns ...Transform[T]) Collection[T] {
+	for i, f := range fns {
+		s = trace(stageName(i), f, s)
+	}
+	return s
+}
+
+// Compose combines the transforms into a single transform.
+// Following the mathematical convention, transforms are applied
+// from right to left, so that Compose(f, g)(c) is equivalent to f(g(c)).
+//
+// example usage:
+//
+//	evensThenDistinct := Compose(distinct, evens)
+//	evensThenDistinct(NewSequence([]int{1,2,2,3,4,4}))
+//
+// output:
+//
+//	[2,4]
+func Compose[T any](fns ...Transform[T]) Transform[T] {
+	return func(s Collection[T]) Collection[T] {
+		for i := len(fns) - 1; i >= 0; i-- {
+			s = trace(stageName(len(fns)-1-i), fns[i], s)
+		}
+		return s
+	}
+}
+
+// tracer is the logger receiving pipeline traces, or nil if tracing is disabled.
+var tracer atomic.Pointer[slog.Logger]
+
+// WithTrace enables tracing of pipelines: every time a stage of Pipe or Compose runs,
+// the logger receives a record with the stage name, the input and output lengths and
+// the duration of the stage, which helps finding where a long pipeline spends time or
+// drops elements. Stages are named after their position in the order they run, "stage 1"
+// being the first one, and a transform wrapped in Step is additionally logged under its
+// own name. It returns a function restoring the previous logger. Tracing is disabled by
+// default.
+//
+// Collection functions such as Filter or Map called outside of a pipeline are not logged.
+//
+// example usage:
+//
+//	defer WithTrace(slog.Default())()
+//	Pipe(c, evens, Step("top10", top10))
+//
+// output:
+//
+//	INFO collection step step="stage 1" in=1000 out=500 duration=41.2µs
+//	INFO collection step step=top10 in=500 out=10 duration=3.1µs
+//	INFO collection step step="stage 2" in=500 out=10 duration=3.4µs
+func WithTrace(logger *slog.Logger) (restore func()) {
+	previous := tracer.Swap(logger)
+	return func() { tracer.Store(previous) }
+}
+
+// Step names a transform, so that its execution is logged under that name when tracing
+// is enabled with WithTrace, even outside of a pipeline. When tracing is disabled, the step
+// runs the transform unchanged.
+func Step[T any](name string, f Transform[T]) Transform[T] {
+	return func(s Collection[T]) Collection[T] {
+		return trace(name, f, s)
+	}
+}
+
+// trace applies the transform to the collection, logging the run
+// under the given name if tracing is enabled.
+func trace[T any](name string, f Transform[T], s Collection[T]) Collection[T] {
+	logger := tracer.Load()
+	if logger == nil {
+		return f(s)
+	}
+	in := s.Length()
+	start := time.Now()
+	result := f(s)
+	logger.LogAttrs(context.Background(), slog.LevelInfo, "collection step",
+		slog.String("step", name),
+		slog.Int("in", in),
+		slog.Int("out", result.Length()),
+		slog.Duration("duration", time.Since(start)),
+	)
+	return result
+}
+
+// stageName returns the default name of the i-th stage run by a pipeline.
+func stageName(i int) string {
+	return fmt.Sprintf("stage %d", i+1)
+}
@@ -0,0 +1,96 @@
+package collection
+
+import (
+	"bytes"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestPipe(t *testing.T) {
+	evens := func(c Collection[int]) Collection[int] {
+		return Filter(c, func(i int) bool { return i%2 == 0 })
+	}
+	double := func(c Collection[int]) Collection[int] {
+		return c.New(Map(c, func(i int) int { return i * 2 }))
+	}
+
+	tests := []struct {
+		name  string
+		input []int
+		fns   []Transform[int]
+		want  []int
+	}{
+		{name: "no transforms", input: []int{1, 2, 3}, fns: nil, want: []int{1, 2, 3}},
+		{name: "filter then double", input: []int{1, 2, 3, 4}, fns: []Transform[int]{evens, double}, want: []int{4, 8}},
+		{name: "double then filter", input: []int{1, 2, 3}, fns: []Transform[int]{double, evens}, want: []int{2, 4, 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Pipe(NewMockCollection(tt.input), tt.fns...).(*MockCollection[int]).items
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Pipe() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompose(t *testing.T) {
+	addOne := func(c Collection[int]) Collection[int] {
+		return c.New(Map(c, func(i int) int { return i + 1 }))
+	}
+	double := func(c Collection[int]) Collection[int] {
+		return c.New(Map(c, func(i int) int { return i * 2 }))
+	}
+
+	got := Compose(addOne, double)(NewMockCollection([]int{1, 2, 3})).(*MockCollection[int]).items
+	if !slices.Equal(got, []int{3, 5, 7}) {
+		t.Errorf("Compose() = %v, want %v", got, []int{3, 5, 7})
+	}
+
+	got = Pipe[int](NewMockCollection([]int{1, 2, 3}), Compose(addOne, double), Compose[int]()).(*MockCollection[int]).items
+	if !slices.Equal(got, []int{3, 5, 7}) {
+		t.Errorf("Pipe(Compose()) = %v, want %v", got, []int{3, 5, 7})
+	}
+}
+
+func TestStep(t *testing.T) {
+	evens := func(c Collection[int]) Collection[int] {
+		return Filter(c, func(i int) bool { return i%2 == 0 })
+	}
+	input := NewMockCollection([]int{1, 2, 3, 4, 5, 6})
+
+	// without tracing the step only runs the transform.
+	got := Pipe(input, Step("evens", evens)).(*MockCollection[int]).items
+	if !slices.Equal(got, []int{2, 4, 6}) {
+		t.Errorf("Step() = %v, want %v", got, []int{2, 4, 6})
+	}
+
+	var buf bytes.Buffer
+	restore := WithTrace(slog.New(slog.NewTextHandler(&buf, nil)))
+	// every stage of a pipeline is traced, named steps are also logged under their name.
+	Pipe(input, evens, Step("head", Compose(func(c Collection[int]) Collection[int] {
+		return c.New(Map(c, func(i int) int { return i })[:1])
+	})))
+	Step("alone", evens)(input)
+	restore()
+	Pipe(input, Step("untraced", evens))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	want := []string{
+		`step="stage 1" in=6 out=3 duration=`,
+		`step="stage 1" in=3 out=1 duration=`,
+		`step=head in=3 out=1 duration=`,
+		`step="stage 2" in=3 out=1 duration=`,
+		`step=alone in=6 out=3 duration=`,
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("WithTrace() logged %v records, want %v: %v", len(lines), len(want), lines)
+	}
+	for i, w := range want {
+		if !strings.Contains(lines[i], w) {
+			t.Errorf("WithTrace() record %v = %v, want it to contain %v", i, lines[i], w)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package collection
+
+import (
+	"fmt"
+	"iter"
+)
+
+// ReadOnlyCollection is a generic interface for a read-only view of a collection.
+// It exposes no mutation methods, which allows libraries to hand out internal
+// collections safely without making defensive copies.
+type ReadOnlyCollection[T any] interface {
+	Length() int
+	Random() T
+	Values() iter.Seq[T]
+}
+
+// ReadOnlyOrderedCollection is a generic interface for a read-only view
+// of an ordered collection.
+type ReadOnlyOrderedCollection[T any] interface {
+	ReadOnlyCollection[T]
+	At(index int) T
+	All() iter.Seq2[int, T]
+	Backward() iter.Seq2[int, T]
+}
+
+// Freeze returns a read-only view of the collection. The view reflects
+// later changes made to the underlying collection, but cannot be used
+// to modify it, not even by asserting it back to a concrete type.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,3})
+//	v := Freeze(c)
+//	v.Length()
+//
+// output:
+//
+//	3
+func Freeze[T any](s Collection[T]) ReadOnlyCollection[T] {
+	return &frozen[T]{s: s}
+}
+
+// FreezeOrdered returns a read-only view of the ordered collection.
+// See Freeze for details.
+func FreezeOrdered[T any](s OrderedCollection[T]) ReadOnlyOrderedCollection[T] {
+	return &frozenOrdered[T]{frozen: frozen[T]{s: s}, o: s}
+}
+
+type frozen[T any] struct {
+	s Collection[T]
+}
+
+func (f *frozen[T]) Length() int         { return f.s.Length() }
+func (f *frozen[T]) Random() T           { return f.s.Random() }
+func (f *frozen[T]) Values() iter.Seq[T] { return f.s.Values() }
+func (f *frozen[T]) String() string      { return fmt.Sprint(f.s) }
+
+type frozenOrdered[T any] struct {
+	frozen[T]
+	o OrderedCollection[T]
+}
+
+func (f *frozenOrdered[T]) At(index int) T              { return f.o.At(index) }
+func (f *frozenOrdered[T]) All() iter.Seq2[int, T]      { return f.o.All() }
+func (f *frozenOrdered[T]) Backward() iter.Seq2[int, T] { return f.o.Backward() }
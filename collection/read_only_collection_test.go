@@ -0,0 +1,50 @@
+package collection
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestFreeze(t *testing.T) {
+	c := NewMockCollection([]int{1, 2, 3})
+	v := Freeze(c)
+	if _, ok := v.(Collection[int]); ok {
+		t.Errorf("Freeze() returned a mutable collection")
+	}
+	if v.Length() != 3 {
+		t.Errorf("Length() = %v, want %v", v.Length(), 3)
+	}
+	c.Add(4)
+	if got := slices.Collect(v.Values()); !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("Values() = %v, want %v", got, []int{1, 2, 3, 4})
+	}
+	if got := fmt.Sprint(v); got != fmt.Sprint(c) {
+		t.Errorf("String() = %v, want %v", got, fmt.Sprint(c))
+	}
+}
+
+func TestFreezeOrdered(t *testing.T) {
+	c := NewMockOrderedCollection([]int{1, 2, 3})
+	v := FreezeOrdered(c)
+	if _, ok := v.(OrderedCollection[int]); ok {
+		t.Errorf("FreezeOrdered() returned a mutable collection")
+	}
+	if v.At(1) != 2 {
+		t.Errorf("At() = %v, want %v", v.At(1), 2)
+	}
+	var backward []int
+	for _, x := range v.Backward() {
+		backward = append(backward, x)
+	}
+	if !slices.Equal(backward, []int{3, 2, 1}) {
+		t.Errorf("Backward() = %v, want %v", backward, []int{3, 2, 1})
+	}
+	var indices []int
+	for i := range v.All() {
+		indices = append(indices, i)
+	}
+	if !slices.Equal(indices, []int{0, 1, 2}) {
+		t.Errorf("All() = %v, want %v", indices, []int{0, 1, 2})
+	}
+}
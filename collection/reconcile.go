@@ -0,0 +1,183 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// reconcile.go defines the reconciliation of a collection with a desired state, the loop
+// at the heart of controller-style programs: compute which elements must be added, removed
+// or updated, act on each change, and optionally apply the changes to the collection.
+
+package collection
+
+import "reflect"
+
+// Update is an element of a target collection and the desired element replacing it.
+type Update[T any] struct {
+	Current T
+	Desired T
+}
+
+// Changes lists what must change for a target collection to match a desired collection.
+type Changes[T any] struct {
+	Added   []T         // desired elements whose key is not in the target
+	Removed []T         // target elements whose key is not desired, or duplicates a previous key
+	Updated []Update[T] // elements whose key is in both collections but which differ
+}
+
+// IsEmpty returns true if the target already matches the desired collection.
+func (c Changes[T]) IsEmpty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0 && len(c.Updated) == 0
+}
+
+// ReconcileOptions configures Reconcile. The zero value only computes the changes.
+type ReconcileOptions[T any] struct {
+	// Equal reports whether an element needs no update,
+	// reflect.DeepEqual if it is nil.
+	Equal func(current, desired T) bool
+	// OnAdd, OnRemove and OnUpdate, if not nil, are called for every change.
+	// An error stops the reconciliation.
+	OnAdd    func(desired T) error
+	OnRemove func(current T) error
+	OnUpdate func(current, desired T) error
+	// Apply makes Reconcile apply the changes to the target, except
+	// the changes whose callback failed or was not called.
+	Apply bool
+}
+
+// Reconcile computes the changes needed for target to match desired, where elements are
+// identified by their key, then calls the callbacks of the options for every removal, update
+// and addition, in this order, and applies the changes to target if opts.Apply is set.
+// Updated elements are removed from target and their desired elements added, so their
+// position changes in ordered collections. Keys are expected to be unique within desired,
+// and the elements of target repeating a key are removed.
+//
+// It returns all the changes, and the first error returned by a callback, which stops the
+// reconciliation: the following changes are neither called back nor applied.
+//
+// example usage:
+//
+//	running := NewSet([]Pod{{"api", "v1"}, {"db", "v1"}})
+//	wanted := NewSequence([]Pod{{"api", "v2"}, {"cache", "v1"}})
+//	Reconcile(running, wanted, func(p Pod) string { return p.Name }, ReconcileOptions[Pod]{
+//		OnAdd:    startPod,
+//		OnRemove: stopPod,
+//		OnUpdate: restartPod,
+//		Apply:    true,
+//	})
+//
+// output:
+//
+//	{Added:[{cache v1}] Removed:[{db v1}] Updated:[{Current:{api v1} Desired:{api v2}}]}, nil
+func Reconcile[T any, K comparable](target MutableCollection[T], desired Collection[T], key func(T) K, opts ReconcileOptions[T]) (Changes[T], error) {
+	equal := opts.Equal
+	if equal == nil {
+		equal = func(a, b T) bool { return reflect.DeepEqual(a, b) }
+	}
+	wanted := make(map[K]T, desired.Length())
+	var order []K
+	for v := range desired.Values() {
+		k := key(v)
+		if _, ok := wanted[k]; !ok {
+			wanted[k] = v
+			order = append(order, k)
+		}
+	}
+	var changes Changes[T]
+	present := make(map[K]T, target.Length()) // the first target element of each desired key
+	for v := range target.Values() {
+		k := key(v)
+		d, ok := wanted[k]
+		if !ok {
+			changes.Removed = append(changes.Removed, v)
+			continue
+		}
+		if _, seen := present[k]; seen {
+			// a duplicate key: only the first element can match the desired element.
+			changes.Removed = append(changes.Removed, v)
+			continue
+		}
+		present[k] = v
+		if !equal(v, d) {
+			changes.Updated = append(changes.Updated, Update[T]{Current: v, Desired: d})
+		}
+	}
+	for _, k := range order {
+		if _, ok := present[k]; !ok {
+			changes.Added = append(changes.Added, wanted[k])
+		}
+	}
+
+	// remove and add the elements whose callback succeeded.
+	removed := make(map[K]struct{})
+	var added []T
+	err := func() error {
+		for _, v := range changes.Removed {
+			if err := invoke(opts.OnRemove, v); err != nil {
+				return err
+			}
+			removed[key(v)] = struct{}{}
+		}
+		for _, u := range changes.Updated {
+			if opts.OnUpdate != nil {
+				if err := opts.OnUpdate(u.Current, u.Desired); err != nil {
+					return err
+				}
+			}
+			removed[key(u.Current)] = struct{}{}
+			delete(present, key(u.Current))
+			added = append(added, u.Desired)
+		}
+		for _, v := range changes.Added {
+			if err := invoke(opts.OnAdd, v); err != nil {
+				return err
+			}
+			added = append(added, v)
+		}
+		return nil
+	}()
+	if opts.Apply {
+		if len(removed) > 0 {
+			target.RemoveWhere(func(v T) bool {
+				_, ok := removed[key(v)]
+				return ok
+			})
+		}
+		// removing a duplicate by key also removed the first element
+		// with that key, which is kept unless it was updated.
+		var restored []T
+		for _, v := range changes.Removed {
+			k := key(v)
+			_, gone := removed[k]
+			if first, ok := present[k]; ok && gone {
+				restored = append(restored, first)
+				delete(present, k)
+			}
+		}
+		target.AddAll(append(restored, added...)...)
+	}
+	return changes, err
+}
+
+// SyncTo makes target match desired in place, removing, replacing and adding elements
+// identified by their key as computed by Reconcile, and returns the changes applied.
+//
+// example usage:
+//
+//	target := NewList([]User{{ID: 1, Name: "ann"}, {ID: 2, Name: "bob"}})
+//	desired := NewSequence([]User{{ID: 2, Name: "bobby"}, {ID: 3, Name: "cat"}})
+//	SyncTo(target, desired, func(u User) int { return u.ID })
+//
+// output:
+//
+//	target: [{2 bobby} {3 cat}]
+func SyncTo[T any, K comparable](target MutableCollection[T], desired Collection[T], key func(T) K) Changes[T] {
+	changes, _ := Reconcile(target, desired, key, ReconcileOptions[T]{Apply: true})
+	return changes
+}
+
+// invoke calls f with v if f is not nil.
+func invoke[T any](f func(T) error, v T) error {
+	if f == nil {
+		return nil
+	}
+	return f(v)
+}
@@ -0,0 +1,93 @@
+package collection
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+type pod struct {
+	name    string
+	version int
+}
+
+func podName(p pod) string { return p.name }
+
+func TestReconcile(t *testing.T) {
+	target := &mockMutableCollection[pod]{MockCollection[pod]{items: []pod{{"api", 1}, {"db", 1}, {"web", 1}}}}
+	desired := NewMockCollection([]pod{{"api", 2}, {"web", 1}, {"cache", 1}})
+	var log []string
+	changes, err := Reconcile(target, desired, podName, ReconcileOptions[pod]{
+		OnAdd:    func(p pod) error { log = append(log, "add "+p.name); return nil },
+		OnRemove: func(p pod) error { log = append(log, "remove "+p.name); return nil },
+		OnUpdate: func(c, d pod) error { log = append(log, "update "+c.name); return nil },
+	})
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	want := Changes[pod]{
+		Added:   []pod{{"cache", 1}},
+		Removed: []pod{{"db", 1}},
+		Updated: []Update[pod]{{Current: pod{"api", 1}, Desired: pod{"api", 2}}},
+	}
+	if !slices.Equal(changes.Added, want.Added) || !slices.Equal(changes.Removed, want.Removed) || !slices.Equal(changes.Updated, want.Updated) {
+		t.Errorf("Reconcile() = %+v, want %+v", changes, want)
+	}
+	if wantLog := []string{"remove db", "update api", "add cache"}; !slices.Equal(log, wantLog) {
+		t.Errorf("Reconcile() callbacks = %v, want %v", log, wantLog)
+	}
+	if len(target.items) != 3 || target.items[0] != (pod{"api", 1}) {
+		t.Errorf("Reconcile() modified the target without Apply: %v", target.items)
+	}
+}
+
+func TestReconcile_ApplyStopsAtError(t *testing.T) {
+	target := &mockMutableCollection[pod]{MockCollection[pod]{items: []pod{{"api", 1}, {"db", 1}}}}
+	desired := NewMockCollection([]pod{{"api", 1}, {"cache", 1}, {"queue", 1}})
+	errFull := errors.New("cluster full")
+	_, err := Reconcile(target, desired, podName, ReconcileOptions[pod]{
+		OnAdd: func(p pod) error {
+			if p.name == "queue" {
+				return errFull
+			}
+			return nil
+		},
+		Apply: true,
+	})
+	if err != errFull {
+		t.Errorf("Reconcile() error = %v, want %v", err, errFull)
+	}
+	if want := []pod{{"api", 1}, {"cache", 1}}; !slices.Equal(target.items, want) {
+		t.Errorf("Reconcile() target = %v, want %v", target.items, want)
+	}
+}
+
+func TestSyncTo(t *testing.T) {
+	target := &mockMutableCollection[pod]{MockCollection[pod]{items: []pod{{"api", 1}, {"db", 1}, {"web", 1}}}}
+	desired := NewMockCollection([]pod{{"api", 2}, {"web", 1}, {"cache", 1}})
+	changes := SyncTo(target, desired, podName)
+	if want := []pod{{"web", 1}, {"api", 2}, {"cache", 1}}; !slices.Equal(target.items, want) {
+		t.Errorf("SyncTo() target = %v, want %v", target.items, want)
+	}
+	if changes.IsEmpty() {
+		t.Errorf("SyncTo() returned no changes")
+	}
+	if changes := SyncTo(target, desired, podName); !changes.IsEmpty() {
+		t.Errorf("SyncTo() = %+v on a synchronized target, want no changes", changes)
+	}
+}
+
+func TestReconcile_DuplicateKeys(t *testing.T) {
+	target := &mockMutableCollection[pod]{MockCollection[pod]{items: []pod{{"api", 1}, {"db", 1}, {"api", 1}, {"db", 2}}}}
+	desired := NewMockCollection([]pod{{"api", 1}, {"db", 3}})
+	changes := SyncTo(target, desired, podName)
+	if want := []pod{{"api", 1}, {"db", 2}}; !slices.Equal(changes.Removed, want) {
+		t.Errorf("SyncTo() removed %v, want %v", changes.Removed, want)
+	}
+	if want := []pod{{"api", 1}, {"db", 3}}; !slices.Equal(target.items, want) {
+		t.Errorf("SyncTo() target = %v, want %v", target.items, want)
+	}
+	if changes := SyncTo(target, desired, podName); !changes.IsEmpty() {
+		t.Errorf("SyncTo() = %+v on a synchronized target, want no changes", changes)
+	}
+}
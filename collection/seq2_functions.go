@@ -0,0 +1,97 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// seq2_functions implements functions that take a key/value iterator as input, such as
+// the index/value iterators returned by All or the iterators returned by Zip and GroupedAll,
+// so that they can be transformed without materializing them into a collection.
+
+package collection
+
+import "iter"
+
+// FilterByKey returns an iterator over the key/value pairs of seq whose key
+// satisfies the predicate.
+//
+// example usage:
+//
+//	c := NewSequence([]string{"a","b","c","d"})
+//	for i, v := range FilterByKey(c.All(), func(i int) bool { return i%2 == 0 }) {
+//	  fmt.Println(i, v)
+//	}
+//
+// output:
+//
+//	0 a
+//	2 c
+func FilterByKey[K, V any](seq iter.Seq2[K, V], f func(K) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range seq {
+			if f(k) && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// MapValues returns an iterator over the key/value pairs of seq where each value
+// is transformed by the function, leaving the keys unchanged.
+//
+// example usage:
+//
+//	c := NewSequence([]string{"a","b"})
+//	for i, v := range MapValues(c.All(), strings.ToUpper) {
+//	  fmt.Println(i, v)
+//	}
+//
+// output:
+//
+//	0 A
+//	1 B
+func MapValues[K, V, W any](seq iter.Seq2[K, V], f func(V) W) iter.Seq2[K, W] {
+	return func(yield func(K, W) bool) {
+		for k, v := range seq {
+			if !yield(k, f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// ReduceValues reduces the values yielded by seq to a single value, calling the
+// function with the accumulator and each value in order, ignoring the keys.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,3})
+//	ReduceValues(c.All(), func(acc, v int) int { return acc + v }, 0)
+//
+// output:
+//
+//	6
+func ReduceValues[K, V, A any](seq iter.Seq2[K, V], f func(A, V) A, init A) A {
+	acc := init
+	for _, v := range seq {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// CollectToMap collects the key/value pairs yielded by seq into a new map.
+// If a key is yielded more than once, the last value wins.
+//
+// example usage:
+//
+//	c := NewSequence([]string{"a","b"})
+//	CollectToMap(MapValues(c.All(), strings.ToUpper))
+//
+// output:
+//
+//	map[0:A 1:B]
+func CollectToMap[K comparable, V any](seq iter.Seq2[K, V]) map[K]V {
+	m := make(map[K]V)
+	for k, v := range seq {
+		m[k] = v
+	}
+	return m
+}
@@ -0,0 +1,48 @@
+package collection
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFilterByKey(t *testing.T) {
+	c := NewMockOrderedCollection([]string{"a", "b", "c", "d"})
+	var got []string
+	for i, v := range FilterByKey(c.All(), func(i int) bool { return i%2 == 0 }) {
+		got = append(got, strings.Repeat(v, i+1))
+	}
+	if !slices.Equal(got, []string{"a", "ccc"}) {
+		t.Errorf("FilterByKey() = %v, want %v", got, []string{"a", "ccc"})
+	}
+	for range FilterByKey(c.All(), func(int) bool { return true }) {
+		break
+	}
+}
+
+func TestMapValues(t *testing.T) {
+	c := NewMockOrderedCollection([]string{"a", "b"})
+	got := CollectToMap(MapValues(c.All(), strings.ToUpper))
+	want := map[int]string{0: "A", 1: "B"}
+	if !maps.Equal(got, want) {
+		t.Errorf("MapValues() = %v, want %v", got, want)
+	}
+}
+
+func TestReduceValues(t *testing.T) {
+	c := NewMockOrderedCollection([]int{1, 2, 3})
+	if got := ReduceValues(c.All(), func(acc string, v int) string { return acc + strings.Repeat("*", v) }, ""); got != "******" {
+		t.Errorf("ReduceValues() = %v, want %v", got, "******")
+	}
+}
+
+func TestCollectToMap(t *testing.T) {
+	s1 := NewMockOrderedCollection([]string{"a", "b", "a"})
+	s2 := NewMockOrderedCollection([]int{1, 2, 3})
+	got := CollectToMap(Zip(s1, s2))
+	want := map[string]int{"a": 3, "b": 2}
+	if !maps.Equal(got, want) {
+		t.Errorf("CollectToMap() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// seq_functions implements functions that take an iterator as input rather than
+// a collection, which allows them to process data that is never fully materialized.
+
+package collection
+
+import (
+	"container/heap"
+	"iter"
+	"slices"
+)
+
+// CollectInto adds the values yielded by seq to the destination collection and returns it,
+// which converts iterators produced by other libraries, such as the proposed x/exp/xiter
+// adapters, into any collection.
+//
+// example usage:
+//
+//	CollectInto(maps.Keys(m), NewSet[string]())
+//
+// possible output:
+//
+//	Set(string) [a b c]
+func CollectInto[T any](seq iter.Seq[T], dst Collection[T]) Collection[T] {
+	for v := range seq {
+		dst.Add(v)
+	}
+	return dst
+}
+
+// Enumerate returns an iterator over index/value pairs of the values yielded by seq,
+// counting from zero, for pipelines built on key/value iterators such as maps.Collect
+// or the functions of this package taking an iter.Seq2.
+//
+// example usage:
+//
+//	for i, v := range Enumerate(set.Values()) {
+//	  fmt.Println(i, v)
+//	}
+//
+// possible output:
+//
+//	0 a
+//	1 b
+func Enumerate[T any](seq iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for v := range seq {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// FromNext returns an iterator over the values produced by calling next until it returns
+// false. It adapts the Next-style iterators of libraries predating range-over-func, and is
+// the inverse of iter.Pull.
+//
+// example usage:
+//
+//	rows := db.Query(...)
+//	for r := range FromNext(rows.Next) {
+//	  ...
+//	}
+func FromNext[T any](next func() (T, bool)) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			v, ok := next()
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Unzip3 splits the triples yielded by seq into three slices holding
+// their first, second and third values respectively. It is the inverse of Zip3.
+//
+// example usage:
+//
+//	rows := NewSequence([]Triple[int, string, bool]{{1, "a", true}, {2, "b", false}})
+//	Unzip3(rows.Values())
+//
+// output:
+//
+//	[1,2], ["a","b"], [true,false]
+func Unzip3[A, B, C any](seq iter.Seq[Triple[A, B, C]]) ([]A, []B, []C) {
+	var first []A
+	var second []B
+	var third []C
+	for t := range seq {
+		first = append(first, t.First)
+		second = append(second, t.Second)
+		third = append(third, t.Third)
+	}
+	return first, second, third
+}
+
+// TopKBy returns the k greatest elements yielded by seq according to the less function,
+// ordered from greatest to smallest. It maintains a bounded heap of k elements while
+// consuming the iterator, so it runs in O(n log k) time and O(k) memory.
+// When elements are equal the ones yielded first are preferred.
+// If k is not positive it returns nil.
+//
+// example usage:
+//
+//	c := NewSequence([]int{5,1,9,3,7})
+//	TopKBy(c.Values(), 3, func(a, b int) bool { return a < b })
+//
+// output:
+//
+//	[9,7,5]
+func TopKBy[T any](seq iter.Seq[T], k int, less func(T, T) bool) []T {
+	if k <= 0 {
+		return nil
+	}
+	h := &topKHeap[T]{less: less}
+	i := 0
+	for v := range seq {
+		item := topKItem[T]{value: v, index: i}
+		i++
+		if h.Len() < k {
+			heap.Push(h, item)
+		} else if less(h.items[0].value, v) {
+			h.items[0] = item
+			heap.Fix(h, 0)
+		}
+	}
+	slices.SortFunc(h.items, func(a, b topKItem[T]) int {
+		if h.worse(b, a) {
+			return -1
+		}
+		if h.worse(a, b) {
+			return 1
+		}
+		return 0
+	})
+	result := make([]T, len(h.items))
+	for j, item := range h.items {
+		result[j] = item.value
+	}
+	return result
+}
+
+// topKItem is an element retained by TopKBy along with its position in the input.
+type topKItem[T any] struct {
+	value T
+	index int
+}
+
+// topKHeap implements heap.Interface as a min-heap of the retained elements,
+// the root being the element that is evicted first.
+type topKHeap[T any] struct {
+	items []topKItem[T]
+	less  func(T, T) bool
+}
+
+// worse reports whether a ranks below b, later elements rank below earlier equal ones.
+func (h *topKHeap[T]) worse(a, b topKItem[T]) bool {
+	if h.less(a.value, b.value) {
+		return true
+	}
+	if h.less(b.value, a.value) {
+		return false
+	}
+	return a.index > b.index
+}
+
+func (h *topKHeap[T]) Len() int { return len(h.items) }
+
+func (h *topKHeap[T]) Less(i, j int) bool { return h.worse(h.items[i], h.items[j]) }
+
+func (h *topKHeap[T]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *topKHeap[T]) Push(x any) { h.items = append(h.items, x.(topKItem[T])) }
+
+func (h *topKHeap[T]) Pop() any {
+	n := len(h.items)
+	item := h.items[n-1]
+	h.items = h.items[:n-1]
+	return item
+}
@@ -0,0 +1,87 @@
+package collection
+
+import (
+	"iter"
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestCollectInto(t *testing.T) {
+	got := CollectInto(slices.Values([]int{1, 2, 3}), NewMockCollection([]int{0})).(*MockCollection[int]).items
+	if !slices.Equal(got, []int{0, 1, 2, 3}) {
+		t.Errorf("CollectInto() = %v, want %v", got, []int{0, 1, 2, 3})
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	got := maps.Collect(Enumerate(slices.Values([]string{"a", "b", "c"})))
+	want := map[int]string{0: "a", 1: "b", 2: "c"}
+	if !maps.Equal(got, want) {
+		t.Errorf("Enumerate() = %v, want %v", got, want)
+	}
+	for i := range Enumerate(slices.Values([]string{"a", "b"})) {
+		if i > 0 {
+			t.Errorf("Enumerate() yielded %v after break", i)
+		}
+		break
+	}
+}
+
+func TestFromNext(t *testing.T) {
+	next, stop := iter.Pull(slices.Values([]int{1, 2, 3}))
+	defer stop()
+	if got := slices.Collect(FromNext(next)); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("FromNext() = %v, want %v", got, []int{1, 2, 3})
+	}
+	i := 0
+	counter := func() (int, bool) { i++; return i, i <= 5 }
+	for v := range FromNext(counter) {
+		if v == 2 {
+			break
+		}
+	}
+	if i != 2 {
+		t.Errorf("FromNext() called next %v times, want %v", i, 2)
+	}
+}
+
+func TestTopKBy(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	tests := []struct {
+		name  string
+		input []int
+		k     int
+		want  []int
+	}{
+		{name: "top 3", input: []int{5, 1, 9, 3, 7}, k: 3, want: []int{9, 7, 5}},
+		{name: "k larger than input", input: []int{2, 1}, k: 5, want: []int{2, 1}},
+		{name: "duplicates", input: []int{4, 4, 1, 4}, k: 2, want: []int{4, 4}},
+		{name: "k is zero", input: []int{1, 2}, k: 0, want: nil},
+		{name: "empty input", input: []int{}, k: 2, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TopKBy(slices.Values(tt.input), tt.k, less)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("TopKBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTopKByPrefersEarlierElements(t *testing.T) {
+	type score struct {
+		name   string
+		points int
+	}
+	scores := NewMockCollection([]score{{"a", 3}, {"b", 5}, {"c", 3}, {"d", 5}, {"e", 3}})
+	got := TopKBy(scores.Values(), 3, func(x, y score) bool { return x.points < y.points })
+	var names []string
+	for _, s := range got {
+		names = append(names, s.name)
+	}
+	if !slices.Equal(names, []string{"b", "d", "a"}) {
+		t.Errorf("TopKBy() = %v, want %v", names, []string{"b", "d", "a"})
+	}
+}
@@ -0,0 +1,503 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// stats.go defines statistical functions that operate on collections of numbers.
+
+package collection
+
+import (
+	"cmp"
+	"math"
+	"math/big"
+	"slices"
+)
+
+// Number is a constraint that permits any integer or floating point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is a constraint that permits any floating point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Summary holds descriptive statistics of a collection of numbers.
+// StdDev is the population standard deviation, and the percentiles
+// are computed using linear interpolation between the closest ranks.
+type Summary struct {
+	Count  int
+	Sum    float64
+	Min    float64
+	Max    float64
+	Mean   float64
+	StdDev float64
+	P25    float64
+	P50    float64
+	P75    float64
+}
+
+// Describe returns a Summary of the collection. Count, sum, min, max, mean and
+// standard deviation are computed in a single pass, percentiles require a sorted
+// copy of the values. If the collection is empty it returns an error.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,3,4,5})
+//	Describe(c)
+//
+// output:
+//
+//	{Count:5 Sum:15 Min:1 Max:5 Mean:3 StdDev:1.414 P25:2 P50:3 P75:4}, nil
+func Describe[T Number](s Collection[T]) (Summary, error) {
+	if s.Length() == 0 {
+		return Summary{}, EmptyCollectionError
+	}
+	summary := Summary{Min: math.Inf(1), Max: math.Inf(-1)}
+	values := make([]float64, 0, s.Length())
+	var m2 float64
+	for v := range s.Values() {
+		x := float64(v)
+		values = append(values, x)
+		summary.Count++
+		summary.Sum += x
+		summary.Min = min(summary.Min, x)
+		summary.Max = max(summary.Max, x)
+		// Welford's online algorithm for the mean and variance.
+		delta := x - summary.Mean
+		summary.Mean += delta / float64(summary.Count)
+		m2 += delta * (x - summary.Mean)
+	}
+	summary.StdDev = math.Sqrt(m2 / float64(summary.Count))
+
+	slices.Sort(values)
+	summary.P25 = percentile(values, 25)
+	summary.P50 = percentile(values, 50)
+	summary.P75 = percentile(values, 75)
+	return summary, nil
+}
+
+// Delta returns a new collection containing the differences between consecutive
+// elements of the collection, i.e. its discrete derivative. The result has one
+// element less than the input, and is empty if the input has fewer than 2 elements.
+// It returns an OverflowError if a difference does not fit in T, for example when
+// an element of unsigned type is less than the previous one.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,4,9,16})
+//	Delta(c)
+//
+// output:
+//
+//	[3,5,7], nil
+func Delta[T Number](s OrderedCollection[T]) (OrderedCollection[T], error) {
+	result := s.NewOrdered()
+	for prev, cur := range Pairwise(s) {
+		d := cur - prev
+		if (cur < prev) != (d < 0) {
+			return nil, OverflowError
+		}
+		result.Add(d)
+	}
+	return result, nil
+}
+
+// Clamp returns a new collection containing the elements of the collection limited to
+// the range [lo, hi]: elements below lo are replaced by lo and elements above hi by hi.
+// It panics with an InvalidArgumentError if lo is greater than hi.
+//
+// example usage:
+//
+//	c := NewSequence([]int{-5,3,12})
+//	Clamp(c, 0, 10)
+//
+// output:
+//
+//	[0,3,10]
+func Clamp[T Number](s OrderedCollection[T], lo, hi T) OrderedCollection[T] {
+	if lo > hi {
+		panic(InvalidArgumentError)
+	}
+	result := s.NewOrdered()
+	for v := range s.Values() {
+		result.Add(min(max(v, lo), hi))
+	}
+	return result
+}
+
+// Plus returns a new collection containing the sums of the elements of the two collections
+// at every index, like the addition of two vectors. If the collections have different
+// lengths it returns a LengthMismatchError.
+//
+// example usage:
+//
+//	c1 := NewSequence([]int{1,2,3})
+//	c2 := NewSequence([]int{10,20,30})
+//	Plus(c1, c2)
+//
+// output:
+//
+//	[11,22,33], nil
+func Plus[T Number](s1, s2 OrderedCollection[T]) (OrderedCollection[T], error) {
+	return elementWise(s1, s2, func(a, b T) T { return a + b })
+}
+
+// Minus returns a new collection containing the differences between the elements of the
+// first and the second collection at every index, like the subtraction of two vectors.
+// If the collections have different lengths it returns a LengthMismatchError.
+//
+// example usage:
+//
+//	c1 := NewSequence([]int{10,20,30})
+//	c2 := NewSequence([]int{1,2,3})
+//	Minus(c1, c2)
+//
+// output:
+//
+//	[9,18,27], nil
+func Minus[T Number](s1, s2 OrderedCollection[T]) (OrderedCollection[T], error) {
+	return elementWise(s1, s2, func(a, b T) T { return a - b })
+}
+
+// Times returns a new collection containing the products of the elements of the two
+// collections at every index, also known as the Hadamard product. If the collections
+// have different lengths it returns a LengthMismatchError.
+//
+// example usage:
+//
+//	c1 := NewSequence([]int{1,2,3})
+//	c2 := NewSequence([]int{4,5,6})
+//	Times(c1, c2)
+//
+// output:
+//
+//	[4,10,18], nil
+func Times[T Number](s1, s2 OrderedCollection[T]) (OrderedCollection[T], error) {
+	return elementWise(s1, s2, func(a, b T) T { return a * b })
+}
+
+// Dot returns the dot product of the two collections, which is the sum of the products of
+// their elements at every index. The dot product of two empty collections is 0. If the
+// collections have different lengths it returns a LengthMismatchError.
+//
+// example usage:
+//
+//	c1 := NewSequence([]int{1,2,3})
+//	c2 := NewSequence([]int{4,5,6})
+//	Dot(c1, c2)
+//
+// output:
+//
+//	32, nil
+func Dot[T Number](s1, s2 OrderedCollection[T]) (T, error) {
+	var sum T
+	if s1.Length() != s2.Length() {
+		return sum, LengthMismatchError
+	}
+	for a, b := range Zip(s1, s2) {
+		sum += a * b
+	}
+	return sum, nil
+}
+
+// elementWise returns a new collection containing the results of applying f
+// to the elements of the two collections at every index.
+func elementWise[T Number](s1, s2 OrderedCollection[T], f func(a, b T) T) (OrderedCollection[T], error) {
+	if s1.Length() != s2.Length() {
+		return nil, LengthMismatchError
+	}
+	result := s1.NewOrdered()
+	for a, b := range Zip(s1, s2) {
+		result.Add(f(a, b))
+	}
+	return result, nil
+}
+
+// Normalize returns a new collection containing the elements of the collection rescaled
+// to the range [0, 1] by min-max normalization: the minimum becomes 0 and the maximum 1.
+// If all the elements are equal, they all become 0.
+//
+// example usage:
+//
+//	c := NewSequence([]float64{10,15,20})
+//	Normalize(c)
+//
+// output:
+//
+//	[0,0.5,1]
+func Normalize[T Float](s OrderedCollection[T]) OrderedCollection[T] {
+	result := s.NewOrdered()
+	if s.Length() == 0 {
+		return result
+	}
+	lo, _ := MinBy(s, func(v T) T { return v })
+	hi, _ := MaxBy(s, func(v T) T { return v })
+	for v := range s.Values() {
+		if hi == lo {
+			result.Add(0)
+		} else {
+			result.Add((v - lo) / (hi - lo))
+		}
+	}
+	return result
+}
+
+// Standardize returns a new collection containing the z-scores of the elements of the
+// collection, i.e. their distance to the mean in units of the population standard deviation,
+// so that the result has a mean of 0 and a standard deviation of 1. If all the elements are
+// equal, they all become 0.
+//
+// example usage:
+//
+//	c := NewSequence([]float64{2,4,4,4,5,5,7,9})
+//	Standardize(c)
+//
+// output:
+//
+//	[-1.5,-0.5,-0.5,-0.5,0,0,1,2]
+func Standardize[T Float](s OrderedCollection[T]) OrderedCollection[T] {
+	result := s.NewOrdered()
+	summary, err := Describe(s)
+	if err != nil {
+		return result
+	}
+	for v := range s.Values() {
+		if summary.StdDev == 0 {
+			result.Add(0)
+		} else {
+			result.Add(T((float64(v) - summary.Mean) / summary.StdDev))
+		}
+	}
+	return result
+}
+
+// Histogram counts the elements of the collection falling into each bucket defined
+// by the edges, which must be sorted in strictly increasing order. For n edges it returns
+// n-1 counts, where bucket i holds the elements in [edges[i], edges[i+1]), except for
+// the last bucket which also includes its upper edge. Elements outside the edges are
+// not counted, and neither are NaN values. It returns an error if fewer than 2 edges are given,
+// if they are not sorted, or if an edge is NaN.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,2,5,7,10,12})
+//	Histogram(c, []int{0,5,10})
+//
+// output:
+//
+//	[3,3], nil
+func Histogram[T cmp.Ordered](s Collection[T], edges []T) ([]int, error) {
+	return HistogramBy(s, func(v T) T { return v }, edges)
+}
+
+// HistogramBy is similar to Histogram but counts the values produced by the
+// projection function, which allows building histograms of struct collections.
+//
+// example usage:
+//
+//	c := NewSequence([]Request{{Latency: 12}, {Latency: 48}, {Latency: 95}})
+//	HistogramBy(c, func(r Request) int { return r.Latency }, []int{0,50,100})
+//
+// output:
+//
+//	[2,1], nil
+func HistogramBy[T any, K cmp.Ordered](s Collection[T], f func(T) K, edges []K) ([]int, error) {
+	if len(edges) < 2 {
+		return nil, InvalidArgumentError
+	}
+	for i, e := range edges {
+		// e != e only holds for NaN, which is unordered and would pass the sortedness check.
+		if e != e || i > 0 && edges[i-1] >= e {
+			return nil, InvalidArgumentError
+		}
+	}
+	counts := make([]int, len(edges)-1)
+	last := edges[len(edges)-1]
+	for v := range s.Values() {
+		k := f(v)
+		if k != k || k < edges[0] || k > last {
+			continue
+		}
+		if k == last {
+			counts[len(counts)-1]++
+			continue
+		}
+		i, found := slices.BinarySearch(edges, k)
+		if !found {
+			i--
+		}
+		counts[i]++
+	}
+	return counts, nil
+}
+
+// CheckedSum returns the sum of the elements of the collection,
+// or an OverflowError if the sum does not fit in T.
+//
+// example usage:
+//
+//	c := NewSequence([]int8{100, 20, 10})
+//	CheckedSum(c)
+//
+// output:
+//
+//	0, error 104: arithmetic overflow
+func CheckedSum[T Integer](s Collection[T]) (T, error) {
+	var sum T
+	for v := range s.Values() {
+		r := sum + v
+		if (v > 0 && r < sum) || (v < 0 && r > sum) {
+			return 0, OverflowError
+		}
+		sum = r
+	}
+	return sum, nil
+}
+
+// SumBig returns the exact sum of the elements of the collection as a big.Int,
+// which never overflows regardless of the number or size of the elements.
+//
+// example usage:
+//
+//	c := NewSequence([]int64{math.MaxInt64, math.MaxInt64})
+//	SumBig(c)
+//
+// output:
+//
+//	18446744073709551614
+func SumBig[T Integer](s Collection[T]) *big.Int {
+	sum := new(big.Int)
+	x := new(big.Int)
+	signed := ^T(0) < 0
+	for v := range s.Values() {
+		if signed {
+			x.SetInt64(int64(v))
+		} else {
+			x.SetUint64(uint64(v))
+		}
+		sum.Add(sum, x)
+	}
+	return sum
+}
+
+// AvgBig returns the exact average of the elements of the collection as a big.Rat,
+// computed from SumBig. It returns an EmptyCollectionError if the collection is empty.
+//
+// example usage:
+//
+//	c := NewSequence([]uint64{math.MaxUint64, 1})
+//	AvgBig(c)
+//
+// output:
+//
+//	9223372036854775808/1, nil
+func AvgBig[T Integer](s Collection[T]) (*big.Rat, error) {
+	if s.Length() == 0 {
+		return nil, EmptyCollectionError
+	}
+	return new(big.Rat).SetFrac(SumBig(s), big.NewInt(int64(s.Length()))), nil
+}
+
+// WeightedSum returns the sum of the values produced by the value function for each
+// element of the collection, each multiplied by the weight produced by the weight function.
+// Elements with a zero weight do not contribute to the sum, even if their value is NaN.
+// It returns 0 for an empty collection.
+//
+// example usage:
+//
+//	c := NewSequence([]Item{{Price: 2, Qty: 3}, {Price: 5, Qty: 1}})
+//	WeightedSum(c, func(i Item) float64 { return i.Price }, func(i Item) int { return i.Qty })
+//
+// output:
+//
+//	11
+func WeightedSum[T any, V, W Number](s Collection[T], value func(T) V, weight func(T) W) float64 {
+	var sum float64
+	for v := range s.Values() {
+		if w := weight(v); w != 0 {
+			sum += float64(value(v)) * float64(w)
+		}
+	}
+	return sum
+}
+
+// WeightedMeanBy returns the weighted arithmetic mean of the values produced by the value
+// function for each element of the collection, weighted by the weight function. Elements
+// with a zero weight are ignored. It returns an EmptyCollectionError if the collection is
+// empty, and an InvalidArgumentError if any weight is negative or if all weights are zero,
+// as the mean is then undefined.
+//
+// example usage:
+//
+//	c := NewSequence([]Grade{{Score: 80, Credits: 3}, {Score: 90, Credits: 1}})
+//	WeightedMeanBy(c, func(g Grade) int { return g.Score }, func(g Grade) int { return g.Credits })
+//
+// output:
+//
+//	82.5, nil
+func WeightedMeanBy[T any, V, W Number](s Collection[T], value func(T) V, weight func(T) W) (float64, error) {
+	if s.Length() == 0 {
+		return 0, EmptyCollectionError
+	}
+	var sum, total float64
+	for v := range s.Values() {
+		w := weight(v)
+		if w < 0 {
+			return 0, InvalidArgumentError
+		}
+		if w == 0 {
+			continue
+		}
+		sum += float64(value(v)) * float64(w)
+		total += float64(w)
+	}
+	if total == 0 {
+		return 0, InvalidArgumentError
+	}
+	return sum / total, nil
+}
+
+// Percentile returns the p-th percentile of the collection, where p is in the range [0, 100].
+// Values between the closest ranks are linearly interpolated. It returns an error
+// if the collection is empty or if p is out of range.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,3,4})
+//	Percentile(c, 50)
+//
+// output:
+//
+//	2.5, nil
+func Percentile[T Number](s Collection[T], p float64) (float64, error) {
+	if s.Length() == 0 {
+		return 0, EmptyCollectionError
+	}
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		return 0, InvalidArgumentError
+	}
+	values := make([]float64, 0, s.Length())
+	for v := range s.Values() {
+		values = append(values, float64(v))
+	}
+	slices.Sort(values)
+	return percentile(values, p), nil
+}
+
+// percentile expects a non-empty sorted slice.
+func percentile(sorted []float64, p float64) float64 {
+	rank := p / 100 * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	return sorted[lo] + (sorted[hi]-sorted[lo])*(rank-float64(lo))
+}
@@ -0,0 +1,356 @@
+package collection
+
+import (
+	"math"
+	"math/big"
+	"slices"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  Summary
+		err   error
+	}{
+		{
+			name:  "empty collection",
+			input: []int{},
+			want:  Summary{},
+			err:   EmptyCollectionError,
+		},
+		{
+			name:  "single element",
+			input: []int{7},
+			want:  Summary{Count: 1, Sum: 7, Min: 7, Max: 7, Mean: 7, StdDev: 0, P25: 7, P50: 7, P75: 7},
+		},
+		{
+			name:  "unsorted elements",
+			input: []int{5, 1, 4, 2, 3},
+			want:  Summary{Count: 5, Sum: 15, Min: 1, Max: 5, Mean: 3, StdDev: math.Sqrt2, P25: 2, P50: 3, P75: 4},
+		},
+		{
+			name:  "interpolated percentiles",
+			input: []int{2, 4, 4, 4, 5, 5, 7, 9},
+			want:  Summary{Count: 8, Sum: 40, Min: 2, Max: 9, Mean: 5, StdDev: 2, P25: 4, P50: 4.5, P75: 5.5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Describe(NewMockCollection(tt.input))
+			if err != tt.err {
+				t.Fatalf("Describe() error = %v, want %v", err, tt.err)
+			}
+			if got.Count != tt.want.Count || !approx(got.Sum, tt.want.Sum) || !approx(got.Min, tt.want.Min) ||
+				!approx(got.Max, tt.want.Max) || !approx(got.Mean, tt.want.Mean) || !approx(got.StdDev, tt.want.StdDev) ||
+				!approx(got.P25, tt.want.P25) || !approx(got.P50, tt.want.P50) || !approx(got.P75, tt.want.P75) {
+				t.Errorf("Describe() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []float64
+		p     float64
+		want  float64
+		err   error
+	}{
+		{name: "median of even count", input: []float64{4, 1, 3, 2}, p: 50, want: 2.5},
+		{name: "minimum", input: []float64{4, 1, 3, 2}, p: 0, want: 1},
+		{name: "maximum", input: []float64{4, 1, 3, 2}, p: 100, want: 4},
+		{name: "empty collection", input: []float64{}, p: 50, err: EmptyCollectionError},
+		{name: "out of range", input: []float64{1}, p: 101, err: InvalidArgumentError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Percentile(NewMockCollection(tt.input), tt.p)
+			if err != tt.err {
+				t.Fatalf("Percentile() error = %v, want %v", err, tt.err)
+			}
+			if !approx(got, tt.want) {
+				t.Errorf("Percentile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func approx(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestHistogram(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		edges []int
+		want  []int
+		err   error
+	}{
+		{name: "two buckets", input: []int{1, 2, 2, 5, 7, 10, 12}, edges: []int{0, 5, 10}, want: []int{3, 3}},
+		{name: "values outside edges", input: []int{-1, 0, 3, 4, 9}, edges: []int{0, 2, 4}, want: []int{1, 2}},
+		{name: "empty collection", input: []int{}, edges: []int{0, 1}, want: []int{0}},
+		{name: "too few edges", input: []int{1}, edges: []int{0}, err: InvalidArgumentError},
+		{name: "unsorted edges", input: []int{1}, edges: []int{0, 5, 3}, err: InvalidArgumentError},
+		{name: "duplicate edges", input: []int{1}, edges: []int{0, 0, 3}, err: InvalidArgumentError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Histogram(NewMockCollection(tt.input), tt.edges)
+			if err != tt.err {
+				t.Fatalf("Histogram() error = %v, want %v", err, tt.err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Histogram() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHistogramBy(t *testing.T) {
+	type request struct {
+		path    string
+		latency float64
+	}
+	c := NewMockCollection([]request{{"/a", 12.5}, {"/b", 48}, {"/c", 95.1}, {"/d", 50}})
+	got, err := HistogramBy(c, func(r request) float64 { return r.latency }, []float64{0, 50, 100})
+	if err != nil {
+		t.Fatalf("HistogramBy() error = %v", err)
+	}
+	if !slices.Equal(got, []int{2, 2}) {
+		t.Errorf("HistogramBy() = %v, want %v", got, []int{2, 2})
+	}
+}
+
+func TestHistogram_NaN(t *testing.T) {
+	c := NewMockCollection([]float64{math.NaN(), 1, 2.5, math.NaN(), 4})
+	got, err := Histogram(c, []float64{0, 2, 4})
+	if err != nil || !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("Histogram() = %v, %v, want %v, %v", got, err, []int{1, 2}, nil)
+	}
+	for _, edges := range [][]float64{{math.NaN(), 1, 2}, {0, math.NaN(), 2}, {0, 1, math.NaN()}} {
+		if _, err := Histogram(c, edges); err != InvalidArgumentError {
+			t.Errorf("Histogram() error = %v with edges %v, want %v", err, edges, InvalidArgumentError)
+		}
+	}
+}
+
+func TestCheckedSum(t *testing.T) {
+	if got, err := CheckedSum(NewMockCollection([]int8{100, 20, 7})); err != nil || got != 127 {
+		t.Errorf("CheckedSum() = %v, %v, want %v, %v", got, err, 127, nil)
+	}
+	if _, err := CheckedSum(NewMockCollection([]int8{100, 20, 10})); err != OverflowError {
+		t.Errorf("CheckedSum() error = %v, want %v", err, OverflowError)
+	}
+	if _, err := CheckedSum(NewMockCollection([]int8{-100, -20, -10})); err != OverflowError {
+		t.Errorf("CheckedSum() error = %v, want %v", err, OverflowError)
+	}
+	if _, err := CheckedSum(NewMockCollection([]uint8{200, 56})); err != OverflowError {
+		t.Errorf("CheckedSum() error = %v, want %v", err, OverflowError)
+	}
+	if got, err := CheckedSum(NewMockCollection([]int{})); err != nil || got != 0 {
+		t.Errorf("CheckedSum() = %v, %v, want %v, %v", got, err, 0, nil)
+	}
+}
+
+func TestSumBig(t *testing.T) {
+	tests := []struct {
+		name string
+		got  *big.Int
+		want string
+	}{
+		{name: "int64 overflow", got: SumBig(NewMockCollection([]int64{math.MaxInt64, math.MaxInt64})), want: "18446744073709551614"},
+		{name: "negative values", got: SumBig(NewMockCollection([]int64{math.MinInt64, math.MinInt64, 5})), want: "-18446744073709551611"},
+		{name: "uint64 overflow", got: SumBig(NewMockCollection([]uint64{math.MaxUint64, 1})), want: "18446744073709551616"},
+		{name: "empty", got: SumBig(NewMockCollection([]int{})), want: "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.String() != tt.want {
+				t.Errorf("SumBig() = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAvgBig(t *testing.T) {
+	got, err := AvgBig(NewMockCollection([]uint64{math.MaxUint64, 1}))
+	if err != nil || got.RatString() != "9223372036854775808" {
+		t.Errorf("AvgBig() = %v, %v, want %v, %v", got, err, "9223372036854775808", nil)
+	}
+	if _, err := AvgBig(NewMockCollection([]int{})); err != EmptyCollectionError {
+		t.Errorf("AvgBig() error = %v, want %v", err, EmptyCollectionError)
+	}
+}
+
+func TestWeightedMeanBy(t *testing.T) {
+	type grade struct {
+		score   float64
+		credits int
+	}
+	score := func(g grade) float64 { return g.score }
+	credits := func(g grade) int { return g.credits }
+	tests := []struct {
+		name    string
+		input   []grade
+		want    float64
+		sum     float64
+		wantErr error
+	}{
+		{name: "weighted", input: []grade{{80, 3}, {90, 1}}, want: 82.5, sum: 330},
+		{name: "zero weight ignored", input: []grade{{80, 2}, {math.NaN(), 0}}, want: 80, sum: 160},
+		{name: "all zero weights", input: []grade{{80, 0}, {90, 0}}, sum: 0, wantErr: InvalidArgumentError},
+		{name: "negative weight", input: []grade{{80, 2}, {90, -1}}, sum: 70, wantErr: InvalidArgumentError},
+		{name: "empty", input: []grade{}, sum: 0, wantErr: EmptyCollectionError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewMockCollection(tt.input)
+			got, err := WeightedMeanBy(c, score, credits)
+			if got != tt.want || err != tt.wantErr {
+				t.Errorf("WeightedMeanBy() = %v, %v, want %v, %v", got, err, tt.want, tt.wantErr)
+			}
+			if sum := WeightedSum(c, score, credits); sum != tt.sum {
+				t.Errorf("WeightedSum() = %v, want %v", sum, tt.sum)
+			}
+		})
+	}
+}
+
+func TestClamp(t *testing.T) {
+	got := Clamp(NewMockOrderedCollection([]int{-5, 3, 12, 10}), 0, 10).(*MockOrderedCollection[int]).items
+	if !slices.Equal(got, []int{0, 3, 10, 10}) {
+		t.Errorf("Clamp() = %v, want %v", got, []int{0, 3, 10, 10})
+	}
+
+	defer func() {
+		if r := recover(); r != InvalidArgumentError {
+			t.Errorf("Clamp() panic = %v, want %v", r, InvalidArgumentError)
+		}
+	}()
+	Clamp(NewMockOrderedCollection([]int{1}), 10, 0)
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []float64
+		want  []float64
+	}{
+		{name: "range", input: []float64{10, 15, 20, 12.5}, want: []float64{0, 0.5, 1, 0.25}},
+		{name: "constant", input: []float64{3, 3}, want: []float64{0, 0}},
+		{name: "empty", input: []float64{}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Normalize(NewMockOrderedCollection(tt.input)).(*MockOrderedCollection[float64]).items
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Normalize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStandardize(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []float64
+		want  []float64
+	}{
+		{name: "z-scores", input: []float64{2, 4, 4, 4, 5, 5, 7, 9}, want: []float64{-1.5, -0.5, -0.5, -0.5, 0, 0, 1, 2}},
+		{name: "constant", input: []float64{3, 3}, want: []float64{0, 0}},
+		{name: "empty", input: []float64{}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Standardize(NewMockOrderedCollection(tt.input)).(*MockOrderedCollection[float64]).items
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Standardize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestElementWise(t *testing.T) {
+	a := NewMockOrderedCollection([]int{1, 2, 3})
+	b := NewMockOrderedCollection([]int{4, 5, 6})
+	tests := []struct {
+		name string
+		f    func(s1, s2 OrderedCollection[int]) (OrderedCollection[int], error)
+		want []int
+	}{
+		{name: "Plus", f: Plus[int], want: []int{5, 7, 9}},
+		{name: "Minus", f: Minus[int], want: []int{-3, -3, -3}},
+		{name: "Times", f: Times[int], want: []int{4, 10, 18}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.f(a, b)
+			if err != nil || !slices.Equal(got.(*MockOrderedCollection[int]).items, tt.want) {
+				t.Errorf("%s() = %v, %v, want %v, %v", tt.name, got, err, tt.want, nil)
+			}
+			if _, err := tt.f(a, NewMockOrderedCollection([]int{1})); err != LengthMismatchError {
+				t.Errorf("%s() error = %v, want %v", tt.name, err, LengthMismatchError)
+			}
+		})
+	}
+}
+
+func TestDot(t *testing.T) {
+	tests := []struct {
+		name    string
+		A       []float64
+		B       []float64
+		want    float64
+		wantErr error
+	}{
+		{name: "vectors", A: []float64{1, 2, 3}, B: []float64{4, 5, 6}, want: 32},
+		{name: "orthogonal", A: []float64{1, 0}, B: []float64{0, 2.5}, want: 0},
+		{name: "empty", A: []float64{}, B: []float64{}, want: 0},
+		{name: "length mismatch", A: []float64{1, 2}, B: []float64{1}, wantErr: LengthMismatchError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Dot(NewMockOrderedCollection(tt.A), NewMockOrderedCollection(tt.B))
+			if got != tt.want || err != tt.wantErr {
+				t.Errorf("Dot() = %v, %v, want %v, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDelta(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []float64
+		want  []float64
+	}{
+		{name: "squares", input: []float64{1, 4, 9, 16}, want: []float64{3, 5, 7}},
+		{name: "decreasing", input: []float64{10, 7.5, 7.5}, want: []float64{-2.5, 0}},
+		{name: "single element", input: []float64{1}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Delta(NewMockOrderedCollection(tt.input))
+			if err != nil || !slices.Equal(got.(*MockOrderedCollection[float64]).items, tt.want) {
+				t.Errorf("Delta() = %v, %v, want %v, %v", got, err, tt.want, nil)
+			}
+		})
+	}
+}
+
+func TestDelta_Overflow(t *testing.T) {
+	got, err := Delta(NewMockOrderedCollection([]uint{1, 4, 9}))
+	if err != nil || !slices.Equal(got.(*MockOrderedCollection[uint]).items, []uint{3, 5}) {
+		t.Errorf("Delta() = %v, %v, want %v, %v", got, err, []uint{3, 5}, nil)
+	}
+	if _, err := Delta(NewMockOrderedCollection([]uint{4, 1})); err != OverflowError {
+		t.Errorf("Delta() error = %v, want %v", err, OverflowError)
+	}
+	if _, err := Delta(NewMockOrderedCollection([]int8{-100, 100})); err != OverflowError {
+		t.Errorf("Delta() error = %v, want %v", err, OverflowError)
+	}
+}
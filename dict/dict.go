@@ -0,0 +1,300 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package dict implements support for a generic Dict.
+// A Dict associates unique keys with values. It wraps an underlying
+// hash map and provides convenience methods and syntatic sugar on top of it,
+// such as filtering and mapping with method chaining.
+//
+// Like Go maps, Dicts iterate in random order. Use the treemap package
+// for a map whose entries are sorted by key.
+package dict
+
+import (
+	"fmt"
+	"iter"
+	"maps"
+
+	"github.com/charbz/gophers/collection"
+)
+
+// Dict is a map of unique keys to values.
+type Dict[K comparable, V any] struct {
+	elements map[K]V
+}
+
+// New is a constructor for an empty Dict.
+func New[K comparable, V any]() *Dict[K, V] {
+	return &Dict[K, V]{elements: make(map[K]V)}
+}
+
+// FromMap is a constructor for a Dict holding a copy of the entries of a Go map.
+func FromMap[K comparable, V any](m map[K]V) *Dict[K, V] {
+	elements := make(map[K]V, len(m))
+	maps.Copy(elements, m)
+	return &Dict[K, V]{elements: elements}
+}
+
+// FromSeq2 is a constructor for a Dict holding the key/value pairs yielded by the iterator.
+// When a key is yielded more than once, its last value wins.
+func FromSeq2[K comparable, V any](seq iter.Seq2[K, V]) *Dict[K, V] {
+	d := New[K, V]()
+	for k, v := range seq {
+		d.elements[k] = v
+	}
+	return d
+}
+
+// Length returns the number of entries in the dict.
+func (d *Dict[K, V]) Length() int {
+	return len(d.elements)
+}
+
+// IsEmpty returns true if the dict has no entries.
+func (d *Dict[K, V]) IsEmpty() bool {
+	return len(d.elements) == 0
+}
+
+// NonEmpty returns true if the dict has at least one entry.
+func (d *Dict[K, V]) NonEmpty() bool {
+	return len(d.elements) > 0
+}
+
+// Put associates the value with the key, replacing any previous value,
+// and reports whether the key was already present.
+func (d *Dict[K, V]) Put(k K, v V) bool {
+	_, ok := d.elements[k]
+	d.elements[k] = v
+	return ok
+}
+
+// Get returns the value associated with the key,
+// and reports whether the key is present.
+func (d *Dict[K, V]) Get(k K) (V, bool) {
+	v, ok := d.elements[k]
+	return v, ok
+}
+
+// GetOr returns the value associated with the key, or def if the key is not present.
+func (d *Dict[K, V]) GetOr(k K, def V) V {
+	if v, ok := d.elements[k]; ok {
+		return v
+	}
+	return def
+}
+
+// Contains returns true if the key is present in the dict.
+func (d *Dict[K, V]) Contains(k K) bool {
+	_, ok := d.elements[k]
+	return ok
+}
+
+// Remove removes the key and its value from the dict,
+// and reports whether the key was present.
+func (d *Dict[K, V]) Remove(k K) bool {
+	_, ok := d.elements[k]
+	delete(d.elements, k)
+	return ok
+}
+
+// RemoveWhere removes the entries that satisfy the predicate
+// and returns the number of entries removed.
+func (d *Dict[K, V]) RemoveWhere(f func(K, V) bool) int {
+	n := len(d.elements)
+	maps.DeleteFunc(d.elements, f)
+	return n - len(d.elements)
+}
+
+// Clear removes all entries from the dict.
+func (d *Dict[K, V]) Clear() {
+	clear(d.elements)
+}
+
+// All returns an iterator over the entries of the dict.
+func (d *Dict[K, V]) All() iter.Seq2[K, V] {
+	return maps.All(d.elements)
+}
+
+// Keys returns an iterator over the keys of the dict.
+func (d *Dict[K, V]) Keys() iter.Seq[K] {
+	return maps.Keys(d.elements)
+}
+
+// Values returns an iterator over the values of the dict, which makes
+// them usable with the iterator functions of the collection package.
+func (d *Dict[K, V]) Values() iter.Seq[V] {
+	return maps.Values(d.elements)
+}
+
+// ToMap returns a new Go map holding the entries of the dict.
+func (d *Dict[K, V]) ToMap() map[K]V {
+	return maps.Clone(d.elements)
+}
+
+// implement the Stringer interface
+func (d *Dict[K, V]) String() string {
+	// fmt prints maps sorted by key.
+	return fmt.Sprintf("Dict(%T, %T) %v", *new(K), *new(V), d.elements)
+}
+
+// The following methods are mostly syntatic sugar
+// enabling method chaining, i.e. d.Filter(f).ForEach(f2)
+
+// Clone returns a copy of the dict. This is a shallow clone.
+func (d *Dict[K, V]) Clone() *Dict[K, V] {
+	return FromMap(d.elements)
+}
+
+// Count returns the number of entries that satisfy the predicate.
+func (d *Dict[K, V]) Count(f func(K, V) bool) int {
+	n := 0
+	for k, v := range d.elements {
+		if f(k, v) {
+			n++
+		}
+	}
+	return n
+}
+
+// Equals returns true if the two dicts hold the same keys
+// with values that are equal according to the function.
+func (d *Dict[K, V]) Equals(d2 *Dict[K, V], f func(V, V) bool) bool {
+	return maps.EqualFunc(d.elements, d2.elements, f)
+}
+
+// Exists returns true if at least one entry satisfies the predicate.
+func (d *Dict[K, V]) Exists(f func(K, V) bool) bool {
+	for k, v := range d.elements {
+		if f(k, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter returns a new dict holding the entries that satisfy the predicate.
+//
+// example usage:
+//
+//	d := FromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+//	d.Filter(func(k string, v int) bool { return v > 1 })
+//
+// output:
+//
+//	Dict(string, int) map[b:2 c:3]
+func (d *Dict[K, V]) Filter(f func(K, V) bool) *Dict[K, V] {
+	r := New[K, V]()
+	for k, v := range d.elements {
+		if f(k, v) {
+			r.elements[k] = v
+		}
+	}
+	return r
+}
+
+// FilterNot returns a new dict holding the entries that do not satisfy the predicate.
+func (d *Dict[K, V]) FilterNot(f func(K, V) bool) *Dict[K, V] {
+	return d.Filter(func(k K, v V) bool { return !f(k, v) })
+}
+
+// ForAll returns true if every entry satisfies the predicate.
+func (d *Dict[K, V]) ForAll(f func(K, V) bool) bool {
+	return !d.Exists(func(k K, v V) bool { return !f(k, v) })
+}
+
+// ForEach calls the function with every entry of the dict, and returns the dict.
+func (d *Dict[K, V]) ForEach(f func(K, V)) *Dict[K, V] {
+	for k, v := range d.elements {
+		f(k, v)
+	}
+	return d
+}
+
+// Map returns a new dict holding the keys of the dict associated with the values
+// returned by the function. Use the MapTo package function to change the type of the values.
+//
+// example usage:
+//
+//	d := FromMap(map[string]int{"a": 1, "b": 2})
+//	d.Map(func(k string, v int) int { return v * 10 })
+//
+// output:
+//
+//	Dict(string, int) map[a:10 b:20]
+func (d *Dict[K, V]) Map(f func(K, V) V) *Dict[K, V] {
+	return MapTo(d, f)
+}
+
+// Partition returns two new dicts, one holding the entries that satisfy
+// the predicate and one holding the entries that do not.
+func (d *Dict[K, V]) Partition(f func(K, V) bool) (*Dict[K, V], *Dict[K, V]) {
+	match, rest := New[K, V](), New[K, V]()
+	for k, v := range d.elements {
+		if f(k, v) {
+			match.elements[k] = v
+		} else {
+			rest.elements[k] = v
+		}
+	}
+	return match, rest
+}
+
+// Update applies the function to every value of the dict in place, and returns the dict.
+func (d *Dict[K, V]) Update(f func(K, V) V) *Dict[K, V] {
+	for k, v := range d.elements {
+		d.elements[k] = f(k, v)
+	}
+	return d
+}
+
+// ValuesWhere returns an iterator over the values of the entries that satisfy the predicate.
+func (d *Dict[K, V]) ValuesWhere(f func(K, V) bool) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for k, v := range d.elements {
+			if f(k, v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// MapTo applies the function to every entry of the dict and returns a new dict
+// associating each key with the returned value. Go does not allow methods to define
+// new type parameters, so this cannot be a method of Dict.
+//
+// example usage:
+//
+//	d := FromMap(map[string]int{"a": 1, "b": 22})
+//	MapTo(d, func(k string, v int) string { return strconv.Itoa(v) })
+//
+// output:
+//
+//	Dict(string, string) map[a:1 b:22]
+func MapTo[K comparable, V, W any](d *Dict[K, V], f func(K, V) W) *Dict[K, W] {
+	r := &Dict[K, W]{elements: make(map[K]W, len(d.elements))}
+	for k, v := range d.elements {
+		r.elements[k] = f(k, v)
+	}
+	return r
+}
+
+// GroupBy returns a new dict associating every key returned by the function
+// with the elements of the collection for which it returned that key, in iteration order.
+//
+// example usage:
+//
+//	words := sequence.NewSequence([]string{"go","zig","rust","c"})
+//	GroupBy(words, func(w string) int { return len(w) })
+//
+// output:
+//
+//	Dict(int, []string) map[1:[c] 2:[go] 3:[zig] 4:[rust]]
+func GroupBy[T any, K comparable](s collection.Collection[T], f func(T) K) *Dict[K, []T] {
+	d := New[K, []T]()
+	for v := range s.Values() {
+		k := f(v)
+		d.elements[k] = append(d.elements[k], v)
+	}
+	return d
+}
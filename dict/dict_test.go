@@ -0,0 +1,129 @@
+package dict
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/charbz/gophers/sequence"
+)
+
+func TestDict_Basics(t *testing.T) {
+	d := New[string, int]()
+	if !d.IsEmpty() || d.NonEmpty() {
+		t.Errorf("IsEmpty() = %v, want %v", d.IsEmpty(), true)
+	}
+	if replaced := d.Put("a", 1); replaced {
+		t.Errorf("Put() = %v for a new key, want %v", replaced, false)
+	}
+	if replaced := d.Put("a", 2); !replaced {
+		t.Errorf("Put() = %v for an existing key, want %v", replaced, true)
+	}
+	d.Put("b", 3)
+	if v, ok := d.Get("a"); v != 2 || !ok {
+		t.Errorf("Get() = %v, %v, want %v, %v", v, ok, 2, true)
+	}
+	if v := d.GetOr("z", -1); v != -1 {
+		t.Errorf("GetOr() = %v, want %v", v, -1)
+	}
+	if !d.Contains("b") || d.Contains("z") || d.Length() != 2 {
+		t.Errorf("Contains() or Length() = %v, want %v", d, "Dict(string, int) map[a:2 b:3]")
+	}
+	if got := d.String(); got != "Dict(string, int) map[a:2 b:3]" {
+		t.Errorf("String() = %v, want %v", got, "Dict(string, int) map[a:2 b:3]")
+	}
+	if !d.Remove("a") || d.Remove("a") {
+		t.Errorf("Remove() did not report whether the key was present")
+	}
+	d.Clear()
+	if d.Length() != 0 {
+		t.Errorf("Clear() = %v, want empty", d)
+	}
+}
+
+func TestFromMap_Nil(t *testing.T) {
+	d := FromMap[string, int](nil)
+	d.Put("a", 1)
+	if v, ok := d.Get("a"); !ok || v != 1 {
+		t.Errorf("Get() = %v, %v, want %v, %v", v, ok, 1, true)
+	}
+}
+
+func TestDict_Iterators(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	d := FromMap(m)
+	if got := maps.Collect(d.All()); !maps.Equal(got, m) {
+		t.Errorf("All() = %v, want %v", got, m)
+	}
+	if got := slices.Sorted(d.Keys()); !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("Keys() = %v, want %v", got, []string{"a", "b", "c"})
+	}
+	if got := slices.Sorted(d.Values()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Values() = %v, want %v", got, []int{1, 2, 3})
+	}
+	if got := slices.Sorted(d.ValuesWhere(func(k string, v int) bool { return k != "b" })); !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("ValuesWhere() = %v, want %v", got, []int{1, 3})
+	}
+	m["d"] = 4
+	if d.Contains("d") {
+		t.Errorf("FromMap() shares entries with the map")
+	}
+	if got := FromSeq2(maps.All(m)); !maps.Equal(got.ToMap(), m) {
+		t.Errorf("FromSeq2() = %v, want %v", got, m)
+	}
+}
+
+func TestDict_Chaining(t *testing.T) {
+	d := FromMap(map[string]int{"a": 1, "b": 2, "c": 3, "d": 4})
+	even := func(k string, v int) bool { return v%2 == 0 }
+	got := d.Filter(even).Map(func(k string, v int) int { return v * 10 })
+	if want := map[string]int{"b": 20, "d": 40}; !maps.Equal(got.ToMap(), want) {
+		t.Errorf("Filter().Map() = %v, want %v", got, want)
+	}
+	if got := d.FilterNot(even); !maps.Equal(got.ToMap(), map[string]int{"a": 1, "c": 3}) {
+		t.Errorf("FilterNot() = %v, want %v", got, map[string]int{"a": 1, "c": 3})
+	}
+	match, rest := d.Partition(even)
+	if match.Length() != 2 || rest.Length() != 2 || !match.Contains("b") || !rest.Contains("a") {
+		t.Errorf("Partition() = %v, %v, want even and odd values", match, rest)
+	}
+	if n := d.Count(even); n != 2 {
+		t.Errorf("Count() = %v, want %v", n, 2)
+	}
+	if !d.Exists(even) || d.ForAll(even) || !d.ForAll(func(string, int) bool { return true }) {
+		t.Errorf("Exists() or ForAll() returned an unexpected result")
+	}
+	sum := 0
+	d.ForEach(func(_ string, v int) { sum += v })
+	if sum != 10 {
+		t.Errorf("ForEach() visited a sum of %v, want %v", sum, 10)
+	}
+	clone := d.Clone().Update(func(_ string, v int) int { return -v })
+	if v, _ := d.Get("a"); v != 1 {
+		t.Errorf("Clone() shares entries with the dict")
+	}
+	if n := clone.RemoveWhere(func(_ string, v int) bool { return v < -2 }); n != 2 || clone.Length() != 2 {
+		t.Errorf("RemoveWhere() = %v, want %v", n, 2)
+	}
+	if !d.Equals(d.Clone(), func(a, b int) bool { return a == b }) || d.Equals(clone, func(a, b int) bool { return a == b }) {
+		t.Errorf("Equals() returned an unexpected result")
+	}
+}
+
+func TestMapTo(t *testing.T) {
+	d := FromMap(map[string]int{"a": 1, "b": 22})
+	got := MapTo(d, func(_ string, v int) string { return strconv.Itoa(v) })
+	if want := map[string]string{"a": "1", "b": "22"}; !maps.Equal(got.ToMap(), want) {
+		t.Errorf("MapTo() = %v, want %v", got, want)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	words := sequence.NewSequence([]string{"go", "zig", "rust", "c", "js"})
+	got := GroupBy(words, func(w string) int { return len(w) })
+	want := map[int][]string{1: {"c"}, 2: {"go", "js"}, 3: {"zig"}, 4: {"rust"}}
+	if !maps.EqualFunc(got.ToMap(), want, slices.Equal) {
+		t.Errorf("GroupBy() = %v, want %v", got, want)
+	}
+}
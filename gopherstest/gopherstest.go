@@ -0,0 +1,122 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package gopherstest implements assertions on collections for use in tests.
+// Every assertion marks itself as a test helper, reports a failure using
+// t.Errorf without stopping the test, and returns whether it succeeded so
+// that callers can stop the test with t.FailNow if needed.
+package gopherstest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/charbz/gophers/collection"
+)
+
+// AssertElementsMatch asserts that the collection holds the same elements as want,
+// in any order, with the same number of occurrences of each element.
+//
+// example usage:
+//
+//	gopherstest.AssertElementsMatch(t, set.NewSet([]int{3,1,2}), []int{1,2,3})
+func AssertElementsMatch[T comparable](t testing.TB, got collection.Collection[T], want []T) bool {
+	t.Helper()
+	counts := make(map[T]int, len(want))
+	for _, v := range want {
+		counts[v]++
+	}
+	for v := range got.Values() {
+		counts[v]--
+	}
+	var missing, extra []T
+	for v, n := range counts {
+		for ; n > 0; n-- {
+			missing = append(missing, v)
+		}
+		for ; n < 0; n++ {
+			extra = append(extra, v)
+		}
+	}
+	if len(missing) == 0 && len(extra) == 0 {
+		return true
+	}
+	t.Errorf("elements do not match:\n got: %v\nwant: %v\nmissing: %v\nextra: %v",
+		values(got), want, missing, extra)
+	return false
+}
+
+// AssertSorted asserts that the elements of the collection are sorted
+// in ascending order according to the comparison function.
+//
+// example usage:
+//
+//	gopherstest.AssertSorted(t, sequence.NewSequence([]int{1,2,3}), cmp.Compare[int])
+func AssertSorted[T any](t testing.TB, got collection.OrderedCollection[T], cmp func(a, b T) int) bool {
+	t.Helper()
+	var prev T
+	for i, v := range got.All() {
+		if i > 0 && cmp(prev, v) > 0 {
+			t.Errorf("elements are not sorted: %v at index %d is greater than %v at index %d\n got: %v",
+				prev, i-1, v, i, values(got))
+			return false
+		}
+		prev = v
+	}
+	return true
+}
+
+// AssertSubset asserts that every element of subset is also an element of superset.
+//
+// example usage:
+//
+//	gopherstest.AssertSubset(t, set.NewSet([]int{1,2}), set.NewSet([]int{1,2,3}))
+func AssertSubset[T comparable](t testing.TB, subset, superset collection.Collection[T]) bool {
+	t.Helper()
+	elements := make(map[T]struct{}, superset.Length())
+	for v := range superset.Values() {
+		elements[v] = struct{}{}
+	}
+	var missing []T
+	for v := range subset.Values() {
+		if _, ok := elements[v]; !ok {
+			missing = append(missing, v)
+		}
+	}
+	if len(missing) == 0 {
+		return true
+	}
+	t.Errorf("not a subset: %v are missing from %v", missing, values(superset))
+	return false
+}
+
+// AssertEventually asserts that the condition becomes true within the timeout, checking it
+// every tick, which is useful to test collections that are updated by other goroutines.
+// The message and its optional arguments are formatted with fmt.Sprintf to describe the
+// condition when the assertion fails.
+//
+// example usage:
+//
+//	gopherstest.AssertEventually(t, func() bool { return queue.Length() == 0 },
+//		time.Second, 10*time.Millisecond, "queue was not drained")
+func AssertEventually(t testing.TB, condition func() bool, timeout, tick time.Duration, msg string, args ...any) bool {
+	t.Helper()
+	deadline := time.Now().Add(timeout)
+	for {
+		if condition() {
+			return true
+		}
+		if time.Now().After(deadline) {
+			t.Errorf("condition not met after %v: %s", timeout, fmt.Sprintf(msg, args...))
+			return false
+		}
+		time.Sleep(tick)
+	}
+}
+
+// values returns the elements of the collection as a slice for error messages.
+func values[T any](c collection.Collection[T]) []T {
+	return collection.AppendToSlice(c, nil)
+}
@@ -0,0 +1,89 @@
+package gopherstest
+
+import (
+	"cmp"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/charbz/gophers/sequence"
+	"github.com/charbz/gophers/set"
+)
+
+// recorder is a testing.TB recording reported failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertElementsMatch(t *testing.T) {
+	tests := []struct {
+		name string
+		got  []int
+		want []int
+		ok   bool
+	}{
+		{name: "same order", got: []int{1, 2, 3}, want: []int{1, 2, 3}, ok: true},
+		{name: "different order", got: []int{3, 1, 2}, want: []int{1, 2, 3}, ok: true},
+		{name: "duplicates", got: []int{1, 1, 2}, want: []int{1, 2, 1}, ok: true},
+		{name: "missing duplicate", got: []int{1, 2}, want: []int{1, 1, 2}, ok: false},
+		{name: "extra element", got: []int{1, 2, 4}, want: []int{1, 2}, ok: false},
+		{name: "both empty", got: []int{}, want: nil, ok: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{TB: t}
+			if ok := AssertElementsMatch(r, sequence.NewSequence(tt.got), tt.want); ok != tt.ok || (len(r.errors) == 0) != tt.ok {
+				t.Errorf("AssertElementsMatch() = %v with errors %v, want %v", ok, r.errors, tt.ok)
+			}
+		})
+	}
+}
+
+func TestAssertSorted(t *testing.T) {
+	r := &recorder{TB: t}
+	if !AssertSorted(r, sequence.NewSequence([]int{1, 2, 2, 5}), cmp.Compare[int]) {
+		t.Errorf("AssertSorted() = false for a sorted sequence: %v", r.errors)
+	}
+	if AssertSorted(r, sequence.NewSequence([]int{1, 3, 2}), cmp.Compare[int]) || len(r.errors) != 1 {
+		t.Errorf("AssertSorted() = true for an unsorted sequence")
+	}
+}
+
+func TestAssertSubset(t *testing.T) {
+	r := &recorder{TB: t}
+	superset := set.NewSet([]string{"a", "b", "c"})
+	if !AssertSubset(r, set.NewSet([]string{"a", "c"}), superset) {
+		t.Errorf("AssertSubset() = false for a subset: %v", r.errors)
+	}
+	if AssertSubset(r, sequence.NewSequence([]string{"a", "d"}), superset) || len(r.errors) != 1 {
+		t.Errorf("AssertSubset() = true for a non subset")
+	}
+}
+
+func TestAssertEventually(t *testing.T) {
+	var n atomic.Int32
+	go func() {
+		for range 3 {
+			time.Sleep(time.Millisecond)
+			n.Add(1)
+		}
+	}()
+	r := &recorder{TB: t}
+	if !AssertEventually(r, func() bool { return n.Load() == 3 }, time.Second, time.Millisecond, "counter") {
+		t.Errorf("AssertEventually() = false: %v", r.errors)
+	}
+	if AssertEventually(r, func() bool { return false }, 5*time.Millisecond, time.Millisecond, "never %s", "true") {
+		t.Errorf("AssertEventually() = true for a condition that never holds")
+	}
+	if len(r.errors) != 1 {
+		t.Errorf("AssertEventually() reported %v errors, want %v", len(r.errors), 1)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// model.go defines reference models of collections backed by plain slices and maps,
+// written as directly as possible so that their behavior is obvious, and a driver
+// applying the same mutations to a collection and a model for differential fuzz tests.
+
+package gopherstest
+
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/charbz/gophers/collection"
+)
+
+// SliceModel is a reference ordered collection backed by a plain slice.
+// It implements the OrderedCollection and MutableCollection interfaces,
+// and Remove removes the first occurrence of a value.
+type SliceModel[T comparable] struct {
+	Elements []T
+}
+
+// NewSliceModel returns a model holding a copy of the slice.
+func NewSliceModel[T comparable](s []T) *SliceModel[T] {
+	return &SliceModel[T]{Elements: slices.Clone(s)}
+}
+
+func (m *SliceModel[T]) Add(v T)             { m.Elements = append(m.Elements, v) }
+func (m *SliceModel[T]) AddAll(v ...T)       { m.Elements = append(m.Elements, v...) }
+func (m *SliceModel[T]) Clear()              { m.Elements = nil }
+func (m *SliceModel[T]) Length() int         { return len(m.Elements) }
+func (m *SliceModel[T]) Values() iter.Seq[T] { return slices.Values(m.Elements) }
+func (m *SliceModel[T]) At(i int) T          { return m.Elements[i] }
+
+func (m *SliceModel[T]) All() iter.Seq2[int, T]      { return slices.All(m.Elements) }
+func (m *SliceModel[T]) Backward() iter.Seq2[int, T] { return slices.Backward(m.Elements) }
+
+func (m *SliceModel[T]) Random() T {
+	if len(m.Elements) == 0 {
+		return *new(T)
+	}
+	return m.Elements[rand.Intn(len(m.Elements))]
+}
+
+func (m *SliceModel[T]) New(s ...[]T) collection.Collection[T] {
+	return NewSliceModel(slices.Concat(s...))
+}
+
+func (m *SliceModel[T]) NewOrdered(s ...[]T) collection.OrderedCollection[T] {
+	return NewSliceModel(slices.Concat(s...))
+}
+
+func (m *SliceModel[T]) Slice(start, end int) collection.OrderedCollection[T] {
+	return NewSliceModel(m.Elements[start:end])
+}
+
+func (m *SliceModel[T]) Remove(v T) bool {
+	i := slices.Index(m.Elements, v)
+	if i == -1 {
+		return false
+	}
+	m.Elements = slices.Delete(m.Elements, i, i+1)
+	return true
+}
+
+func (m *SliceModel[T]) RemoveWhere(f func(T) bool) int {
+	n := len(m.Elements)
+	m.Elements = slices.DeleteFunc(m.Elements, f)
+	return n - len(m.Elements)
+}
+
+// SetModel is a reference set backed by a plain map.
+// It implements the Collection and MutableCollection interfaces.
+type SetModel[T comparable] struct {
+	Elements map[T]struct{}
+}
+
+// NewSetModel returns a model holding the values of the slice.
+func NewSetModel[T comparable](s []T) *SetModel[T] {
+	m := &SetModel[T]{Elements: make(map[T]struct{})}
+	m.AddAll(s...)
+	return m
+}
+
+func (m *SetModel[T]) Add(v T)             { m.Elements[v] = struct{}{} }
+func (m *SetModel[T]) Clear()              { clear(m.Elements) }
+func (m *SetModel[T]) Length() int         { return len(m.Elements) }
+func (m *SetModel[T]) Values() iter.Seq[T] { return maps.Keys(m.Elements) }
+
+func (m *SetModel[T]) AddAll(v ...T) {
+	for _, e := range v {
+		m.Add(e)
+	}
+}
+
+func (m *SetModel[T]) Random() T {
+	for v := range m.Elements {
+		return v
+	}
+	return *new(T)
+}
+
+func (m *SetModel[T]) New(s ...[]T) collection.Collection[T] {
+	return NewSetModel(slices.Concat(s...))
+}
+
+func (m *SetModel[T]) Remove(v T) bool {
+	_, ok := m.Elements[v]
+	delete(m.Elements, v)
+	return ok
+}
+
+func (m *SetModel[T]) RemoveWhere(f func(T) bool) int {
+	n := len(m.Elements)
+	maps.DeleteFunc(m.Elements, func(v T, _ struct{}) bool { return f(v) })
+	return n - len(m.Elements)
+}
+
+// AssertSameMutations decodes the data into a sequence of mutations, applies each one
+// to both got and want, and asserts that they return the same results and hold the same
+// elements after every mutation, in the same order if ordered is true. Each pair of bytes
+// selects a mutation (Add, AddAll, Remove, RemoveWhere or Clear) and its argument, which
+// is converted to an element by the value function. It is meant to be called from fuzz
+// tests with a reference model such as SliceModel or SetModel as want.
+//
+// example usage:
+//
+//	func FuzzSequence(f *testing.F) {
+//	  f.Fuzz(func(t *testing.T, data []byte) {
+//	    gopherstest.AssertSameMutations(t, data, sequence.NewComparableSequence[int](),
+//	      gopherstest.NewSliceModel[int](nil), func(b byte) int { return int(b % 8) }, true)
+//	  })
+//	}
+func AssertSameMutations[T comparable](
+	t testing.TB,
+	data []byte,
+	got, want collection.MutableCollection[T],
+	value func(byte) T,
+	ordered bool,
+) bool {
+	t.Helper()
+	for i := 0; i+1 < len(data); i += 2 {
+		v := value(data[i+1])
+		var op string
+		var gotResult, wantResult any
+		switch data[i] % 5 {
+		case 0:
+			op = fmt.Sprintf("Add(%v)", v)
+			got.Add(v)
+			want.Add(v)
+		case 1:
+			w := value(data[i+1] / 2)
+			op = fmt.Sprintf("AddAll(%v, %v)", v, w)
+			got.AddAll(v, w)
+			want.AddAll(v, w)
+		case 2:
+			op = fmt.Sprintf("Remove(%v)", v)
+			gotResult, wantResult = got.Remove(v), want.Remove(v)
+		case 3:
+			op = fmt.Sprintf("RemoveWhere(== %v)", v)
+			f := func(e T) bool { return e == v }
+			gotResult, wantResult = got.RemoveWhere(f), want.RemoveWhere(f)
+		case 4:
+			op = "Clear()"
+			got.Clear()
+			want.Clear()
+		}
+		if gotResult != wantResult {
+			t.Errorf("mutation %d: %s = %v, want %v", i/2, op, gotResult, wantResult)
+			return false
+		}
+		gotValues := collection.AppendToSlice(got, nil)
+		wantValues := collection.AppendToSlice(want, nil)
+		if ordered && !slices.Equal(gotValues, wantValues) ||
+			!ordered && !maps.Equal(counts(gotValues), counts(wantValues)) ||
+			got.Length() != want.Length() {
+			t.Errorf("mutation %d: after %s got %v with length %d, want %v with length %d",
+				i/2, op, gotValues, got.Length(), wantValues, want.Length())
+			return false
+		}
+	}
+	return true
+}
+
+// counts returns the number of occurrences of each value of the slice.
+func counts[T comparable](s []T) map[T]int {
+	m := make(map[T]int, len(s))
+	for _, v := range s {
+		m[v]++
+	}
+	return m
+}
@@ -0,0 +1,30 @@
+package gopherstest
+
+import (
+	"testing"
+
+	"github.com/charbz/gophers/collection"
+)
+
+func TestSliceModel_ImplementsCollections(t *testing.T) {
+	var _ collection.OrderedCollection[int] = NewSliceModel[int](nil)
+	var _ collection.MutableCollection[int] = NewSliceModel[int](nil)
+	var _ collection.MutableCollection[int] = NewSetModel[int](nil)
+}
+
+func TestAssertSameMutations(t *testing.T) {
+	value := func(b byte) int { return int(b % 4) }
+	data := []byte{0, 1, 1, 2, 0, 1, 2, 1, 3, 2, 4, 0, 0, 3}
+
+	r := &recorder{TB: t}
+	if !AssertSameMutations(r, data, NewSliceModel[int](nil), NewSliceModel[int](nil), value, true) {
+		t.Errorf("AssertSameMutations() = false for identical models: %v", r.errors)
+	}
+	if !AssertSameMutations(r, data, NewSetModel[int](nil), NewSetModel[int](nil), value, false) {
+		t.Errorf("AssertSameMutations() = false for identical models: %v", r.errors)
+	}
+	// a set does not keep duplicates added by AddAll.
+	if AssertSameMutations(r, data, NewSetModel[int](nil), NewSliceModel[int](nil), value, false) || len(r.errors) != 1 {
+		t.Errorf("AssertSameMutations() = true for diverging models")
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package fanin merges the values received from several channels into a single
+// iterator. It backs collection.FromChannels, and lets the packages building
+// collections from channels learn why the iteration ended.
+package fanin
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// Merge returns an iterator over the values received from all the channels, fanning
+// them in as they arrive, until every channel is closed or ctx is done. If drained is
+// not nil, it is set to true when the iteration ends because every channel was closed
+// and all their values were yielded.
+func Merge[T any](ctx context.Context, drained *bool, chs ...<-chan T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		done := make(chan struct{})
+		defer close(done)
+		merged := make(chan T)
+		var wg sync.WaitGroup
+		wg.Add(len(chs))
+		for _, ch := range chs {
+			go func() {
+				defer wg.Done()
+				for {
+					select {
+					case v, ok := <-ch:
+						if !ok {
+							return
+						}
+						select {
+						case merged <- v:
+						case <-done:
+							return
+						}
+					case <-done:
+						return
+					}
+				}
+			}()
+		}
+		go func() {
+			wg.Wait()
+			close(merged)
+		}()
+		for {
+			select {
+			case v, ok := <-merged:
+				if !ok {
+					if drained != nil {
+						*drained = true
+					}
+					return
+				}
+				if !yield(v) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
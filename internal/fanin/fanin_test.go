@@ -0,0 +1,38 @@
+package fanin
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestMerge_Drained(t *testing.T) {
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 2
+	close(ch)
+	var drained bool
+	if got := slices.Collect(Merge(context.Background(), &drained, ch)); !slices.Equal(got, []int{1, 2}) || !drained {
+		t.Errorf("Merge() = %v drained %v, want %v drained %v", got, drained, []int{1, 2}, true)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	drained = false
+	for range Merge(ctx, &drained, make(chan int)) {
+		t.Errorf("Merge() yielded a value from an empty channel")
+	}
+	if drained {
+		t.Errorf("Merge() drained = %v after cancellation, want %v", drained, false)
+	}
+
+	open := make(chan int, 1)
+	open <- 1
+	drained = false
+	for range Merge(context.Background(), &drained, open) {
+		break
+	}
+	if drained {
+		t.Errorf("Merge() drained = %v after the loop stopped early, want %v", drained, false)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// adapters.go defines conversions between a List and the doubly linked list
+// of the container/list package, from iterators and channels to a List, from
+// Lists to a Sequence, and between a List and a ComparableList.
+
+package list
+
+import (
+	"cmp"
+	containerlist "container/list"
+	"context"
+	"iter"
+
+	"github.com/charbz/gophers/collection"
+	"github.com/charbz/gophers/internal/fanin"
+	"github.com/charbz/gophers/sequence"
+)
+
+// FromSeq returns a new list holding the values yielded by the iterator, in order.
+//
+// example usage:
+//
+//	FromSeq(slices.Values([]int{1,2,3}))
+//
+// output:
+//
+//	List(int) [1 2 3]
+func FromSeq[T any](seq iter.Seq[T]) *List[T] {
+	l := NewList[T]()
+	for v := range seq {
+		l.Add(v)
+	}
+	return l
+}
+
+// FromChannels returns a new list holding the values received from all the channels,
+// see collection.FromChannels, once every channel is closed. If ctx is done before,
+// it returns the values received so far along with the context error. No error is
+// returned if every channel was closed and drained, even if ctx is done by then.
+//
+// example usage:
+//
+//	l, err := FromChannels(ctx, producer1, producer2)
+//
+// possible output:
+//
+//	List(int) [1 10 2 20 3], nil
+func FromChannels[T any](ctx context.Context, chs ...<-chan T) (*List[T], error) {
+	var drained bool
+	l := FromSeq(fanin.Merge(ctx, &drained, chs...))
+	if !drained {
+		return l, ctx.Err()
+	}
+	return l, nil
+}
+
+// ConcatInto appends the elements of all the lists to the destination sequence, in order,
+// and returns it. The sequence is grown once to the combined length of the lists before
+// any element is copied, which makes flattening many small lists into a slice-backed
+// sequence a single allocation at most.
+//
+// example usage:
+//
+//	dst := sequence.NewSequence[int]()
+//	ConcatInto(dst, NewList([]int{1,2}), NewList([]int{3}), NewList([]int{4,5}))
+//
+// output:
+//
+//	Seq(int) [1 2 3 4 5]
+func ConcatInto[T any](dst *sequence.Sequence[T], lists ...*List[T]) *sequence.Sequence[T] {
+	n := 0
+	for _, l := range lists {
+		n += l.size
+	}
+	dst.Grow(n)
+	for _, l := range lists {
+		for node := l.head; node != nil; node = node.next {
+			dst.Add(node.value)
+		}
+	}
+	return dst
+}
+
+// FromContainerList returns a new list holding the values of a container/list.List.
+// It returns an InvalidArgumentError if any value is not of type T.
+//
+// example usage:
+//
+//	cl := list.New()
+//	cl.PushBack(1)
+//	cl.PushBack(2)
+//	FromContainerList[int](cl)
+//
+// output:
+//
+//	List(int) [1 2], nil
+func FromContainerList[T any](cl *containerlist.List) (*List[T], error) {
+	l := NewList[T]()
+	for e := cl.Front(); e != nil; e = e.Next() {
+		v, ok := e.Value.(T)
+		if !ok {
+			return nil, collection.InvalidArgumentError
+		}
+		l.Add(v)
+	}
+	return l, nil
+}
+
+// ToContainerList returns a new container/list.List holding the values of the list.
+func (l *List[T]) ToContainerList() *containerlist.List {
+	cl := containerlist.New()
+	for v := range l.Values() {
+		cl.PushBack(v)
+	}
+	return cl
+}
+
+// AsComparableList moves the nodes of the list into a new comparable list without allocating
+// or copying them, so that a list of ordered values can be passed to APIs requiring a
+// ComparableList without rebuilding it element by element. The list is left empty, as its
+// nodes, including those returned by AddNode, are now owned by the returned list. Use NewComparableList to create a comparable list holding a copy instead.
+//
+// example usage:
+//
+//	l := NewList([]int{3,1,2})
+//	AsComparableList(l).Max()
+//
+// output:
+//
+//	3, nil
+func AsComparableList[T cmp.Ordered](l *List[T]) *ComparableList[T] {
+	c := &ComparableList[T]{List: *l}
+	for node := c.head; node != nil; node = node.next {
+		node.list = &c.List
+	}
+	*l = List[T]{}
+	return c
+}
+
+// AsList returns the list underlying the comparable list, without copying it,
+// so that it can be passed to APIs requiring a List. The returned list shares its
+// state with the comparable list: any change made through one is visible in the other.
+func (l *ComparableList[T]) AsList() *List[T] {
+	return &l.List
+}
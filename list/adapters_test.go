@@ -0,0 +1,135 @@
+package list
+
+import (
+	containerlist "container/list"
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/charbz/gophers/collection"
+	"github.com/charbz/gophers/sequence"
+)
+
+func TestFromSeq(t *testing.T) {
+	l := FromSeq(slices.Values([]int{3, 1, 2}))
+	if got := l.ToSlice(); !slices.Equal(got, []int{3, 1, 2}) || l.Length() != 3 {
+		t.Errorf("FromSeq() = %v, want %v", got, []int{3, 1, 2})
+	}
+	if got := FromSeq(slices.Values([]int{})); !got.IsEmpty() {
+		t.Errorf("FromSeq() = %v, want empty", got)
+	}
+}
+
+func TestConcatInto(t *testing.T) {
+	dst := sequence.NewSequence([]int{0})
+	got := ConcatInto(dst, NewList([]int{1, 2}), NewList[int](), NewList([]int{3}))
+	if got != dst || !slices.Equal(got.ToSlice(), []int{0, 1, 2, 3}) {
+		t.Errorf("ConcatInto() = %v, want %v", got, []int{0, 1, 2, 3})
+	}
+	if got := ConcatInto(sequence.NewSequence[int]()); got.Length() != 0 {
+		t.Errorf("ConcatInto() = %v, want empty", got)
+	}
+	lists := []*List[int]{NewList([]int{1, 2}), NewList([]int{3}), NewList([]int{4, 5, 6})}
+	dst = sequence.NewSequence[int]()
+	// growing the sequence once is the only allocation.
+	want := testing.AllocsPerRun(10, func() {
+		dst.Clear()
+		dst.Grow(6)
+	})
+	if allocs := testing.AllocsPerRun(10, func() {
+		dst.Clear()
+		ConcatInto(dst, lists...)
+	}); allocs > want {
+		t.Errorf("ConcatInto() allocs = %v, want at most %v", allocs, want)
+	}
+}
+
+func TestFromChannels(t *testing.T) {
+	ch1, ch2 := make(chan int, 2), make(chan int, 1)
+	ch1 <- 1
+	ch1 <- 2
+	ch2 <- 3
+	close(ch1)
+	close(ch2)
+	l, err := FromChannels(context.Background(), ch1, ch2)
+	if got := slices.Sorted(l.Values()); !slices.Equal(got, []int{1, 2, 3}) || err != nil {
+		t.Errorf("FromChannels() = %v, %v, want %v, %v", got, err, []int{1, 2, 3}, nil)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	l, err = FromChannels(ctx, make(chan int))
+	if l.Length() != 0 || err != context.Canceled {
+		t.Errorf("FromChannels() = %v, %v, want empty, %v", l, err, context.Canceled)
+	}
+}
+
+func TestFromContainerList(t *testing.T) {
+	cl := containerlist.New()
+	cl.PushBack(1)
+	cl.PushBack(2)
+	cl.PushFront(0)
+	l, err := FromContainerList[int](cl)
+	if err != nil {
+		t.Fatalf("FromContainerList() error = %v", err)
+	}
+	if !slices.Equal(l.ToSlice(), []int{0, 1, 2}) {
+		t.Errorf("FromContainerList() = %v, want %v", l.ToSlice(), []int{0, 1, 2})
+	}
+
+	cl.PushBack("three")
+	if _, err := FromContainerList[int](cl); err != collection.InvalidArgumentError {
+		t.Errorf("FromContainerList() error = %v, want %v", err, collection.InvalidArgumentError)
+	}
+}
+
+func TestList_ToContainerList(t *testing.T) {
+	cl := NewList([]string{"a", "b", "c"}).ToContainerList()
+	var got []string
+	for e := cl.Front(); e != nil; e = e.Next() {
+		got = append(got, e.Value.(string))
+	}
+	if !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("ToContainerList() = %v, want %v", got, []string{"a", "b", "c"})
+	}
+}
+
+func TestAsComparableList(t *testing.T) {
+	l := NewList([]int{3, 1, 2})
+	cl := AsComparableList(l)
+	if m, err := cl.Max(); m != 3 || err != nil {
+		t.Errorf("Max() = %v, %v, want %v, %v", m, err, 3, nil)
+	}
+	if !slices.Equal(cl.ToSlice(), []int{3, 1, 2}) || l.Length() != 0 {
+		t.Errorf("AsComparableList() = %v, %v, want %v, %v", cl.ToSlice(), l.ToSlice(), []int{3, 1, 2}, []int{})
+	}
+	l.Add(4)
+	if cl.Length() != 3 {
+		t.Errorf("AsComparableList() length = %v, want %v", cl.Length(), 3)
+	}
+}
+
+func TestAsComparableList_Nodes(t *testing.T) {
+	l := NewList([]int{1})
+	a := l.AddNode(2)
+	b := l.AddNode(3)
+	cl := AsComparableList(l)
+	l.RemoveNode(a)
+	if l.Length() != 0 || cl.Length() != 3 {
+		t.Errorf("RemoveNode() on the original list = %v, %v, want %v, %v", l.Length(), cl.Length(), 0, 3)
+	}
+	cl.MoveToBack(a)
+	cl.RemoveNode(b)
+	if !slices.Equal(cl.ToSlice(), []int{1, 2}) {
+		t.Errorf("AsComparableList() nodes = %v, want %v", cl.ToSlice(), []int{1, 2})
+	}
+}
+
+func TestComparableList_AsList(t *testing.T) {
+	cl := NewComparableList([]int{1, 2})
+	l := cl.AsList()
+	l.Add(3)
+	if !slices.Equal(cl.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("AsList() = %v, want %v", cl.ToSlice(), []int{1, 2, 3})
+	}
+}
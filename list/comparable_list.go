@@ -40,6 +40,32 @@ func NewComparableList[T cmp.Ordered](s ...[]T) *ComparableList[T] {
 	return list
 }
 
+// NewComparableListOf is a constructor for a list holding the given values.
+func NewComparableListOf[T cmp.Ordered](v ...T) *ComparableList[T] {
+	return NewComparableList(v)
+}
+
+// ApplyCount applies a function to each element in the list and returns the
+// number of elements whose value changed, as compared using ==.
+func (l *ComparableList[T]) ApplyCount(f func(T) T) int {
+	return l.ApplyChanged(func(v T) (T, bool) {
+		u := f(v)
+		return u, u != v
+	})
+}
+
+// ApplyWhereCount applies a function to each element in the list that satisfies
+// the predicate and returns the number of elements whose value changed.
+func (l *ComparableList[T]) ApplyWhereCount(p func(T) bool, f func(T) T) int {
+	return l.ApplyChanged(func(v T) (T, bool) {
+		if !p(v) {
+			return v, false
+		}
+		u := f(v)
+		return u, u != v
+	})
+}
+
 // Clone returns a copy of the list. This is a shallow clone.
 func (l *ComparableList[T]) Clone() *ComparableList[T] {
 	clone := &ComparableList[T]{}
@@ -80,6 +106,11 @@ func (l *ComparableList[T]) Corresponds(s *ComparableList[T], f func(T, T) bool)
 	return collection.Corresponds(l, s, f)
 }
 
+// CountValue is an alias for collection.CountValue
+func (l *ComparableList[T]) CountValue(v T) int {
+	return collection.CountValue(l, v)
+}
+
 // Distinct returns a new list containing only the unique elements from the original list.
 func (l *ComparableList[T]) Distinct() *ComparableList[T] {
 	m := make(map[T]struct{})
@@ -94,6 +125,11 @@ func (l *ComparableList[T]) Distinct() *ComparableList[T] {
 	return r
 }
 
+// DistinctKeepLast is an alias for collection.DistinctKeepLast
+func (l *ComparableList[T]) DistinctKeepLast() *ComparableList[T] {
+	return collection.MustCast[*ComparableList[T]]("DistinctKeepLast", collection.DistinctKeepLast(l))
+}
+
 // Distincted is an alias for collection.Distincted
 func (l *ComparableList[T]) Distincted() iter.Seq[T] {
 	return collection.Distincted(l)
@@ -101,7 +137,7 @@ func (l *ComparableList[T]) Distincted() iter.Seq[T] {
 
 // Diff returns a new list containing the elements of the original list that are not in the other list.
 func (l *ComparableList[T]) Diff(s *ComparableList[T]) *ComparableList[T] {
-	return collection.Diff(l, s).(*ComparableList[T])
+	return collection.MustCast[*ComparableList[T]]("Diff", collection.Diff(l, s))
 }
 
 // Diffed is an alias for collection.Diffed
@@ -131,6 +167,16 @@ func (l *ComparableList[T]) Equals(s *ComparableList[T]) bool {
 	return true
 }
 
+// Fingerprint is an alias for collection.Fingerprint
+func (l *ComparableList[T]) Fingerprint() uint64 {
+	return collection.Fingerprint(l)
+}
+
+// Frequencies is an alias for collection.Frequencies
+func (l *ComparableList[T]) Frequencies() map[T]int {
+	return collection.Frequencies(l)
+}
+
 // IndexOf returns the index of the first occurrence of the specified element in this list,
 func (l *ComparableList[T]) IndexOf(v T) int {
 	for i, val := range l.All() {
@@ -143,7 +189,7 @@ func (l *ComparableList[T]) IndexOf(v T) int {
 
 // Intersect returns a new list containing the elements that are present in both lists.
 func (l *ComparableList[T]) Intersect(s *ComparableList[T]) *ComparableList[T] {
-	return collection.Intersect(l, s).(*ComparableList[T])
+	return collection.MustCast[*ComparableList[T]]("Intersect", collection.Intersect(l, s))
 }
 
 // Intersected is an alias for collection.Intersected
@@ -171,6 +217,11 @@ func (l *ComparableList[T]) Min() (T, error) {
 	return collection.MinBy(l, func(v T) T { return v })
 }
 
+// MostCommon is an alias for collection.MostCommon
+func (l *ComparableList[T]) MostCommon(n int) []collection.Frequency[T] {
+	return collection.MostCommon(l, n)
+}
+
 // Sum returns the sum of the elements in the list.
 func (l *ComparableList[T]) Sum() T {
 	var sum T
@@ -180,6 +231,25 @@ func (l *ComparableList[T]) Sum() T {
 	return sum
 }
 
+// Remove removes the first occurrence of a value from the list
+// and returns true if a value was removed. It implements the
+// MutableCollection interface, lists of other types can use RemoveWhere instead.
+func (l *ComparableList[T]) Remove(v T) bool {
+	for node := l.head; node != nil; node = node.next {
+		if node.value == v {
+			l.unlink(node)
+			return true
+		}
+	}
+	return false
+}
+
+// ReplaceAll replaces every occurrence of old with new
+// and returns the number of values replaced.
+func (l *ComparableList[T]) ReplaceAll(old, new T) int {
+	return l.ReplaceAllFunc(func(v T) bool { return v == old }, new)
+}
+
 // StartsWith returns true if the list starts with the given list.
 func (l *ComparableList[T]) StartsWith(other *ComparableList[T]) bool {
 	return collection.StartsWith(l, other)
@@ -189,3 +259,8 @@ func (l *ComparableList[T]) StartsWith(other *ComparableList[T]) bool {
 func (l *ComparableList[T]) EndsWith(other *ComparableList[T]) bool {
 	return collection.EndsWith(l, other)
 }
+
+// EqualsRotated is an alias for collection.EqualsRotated
+func (l *ComparableList[T]) EqualsRotated(other *ComparableList[T]) bool {
+	return collection.EqualsRotated(l, other)
+}
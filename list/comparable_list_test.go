@@ -3,6 +3,8 @@ package list
 import (
 	"slices"
 	"testing"
+
+	"github.com/charbz/gophers/collection"
 )
 
 func TestComparableList_Contains(t *testing.T) {
@@ -43,6 +45,16 @@ func TestComparableList_Contains(t *testing.T) {
 	}
 }
 
+func TestComparableList_CountValue(t *testing.T) {
+	l := NewComparableList([]string{"a", "b", "a", "c"})
+	if got := l.CountValue("a"); got != 2 {
+		t.Errorf("CountValue() = %v, want %v", got, 2)
+	}
+	if got := l.CountValue("z"); got != 0 {
+		t.Errorf("CountValue() = %v, want %v", got, 0)
+	}
+}
+
 func TestComparableList_Distinct(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -77,6 +89,13 @@ func TestComparableList_Distinct(t *testing.T) {
 	}
 }
 
+func TestComparableList_DistinctKeepLast(t *testing.T) {
+	l := NewComparableList([]string{"a", "b", "a", "c", "b"})
+	if got := l.DistinctKeepLast().ToSlice(); !slices.Equal(got, []string{"a", "c", "b"}) {
+		t.Errorf("DistinctKeepLast() = %v, want %v", got, []string{"a", "c", "b"})
+	}
+}
+
 func TestComparableList_Diff(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -476,3 +495,91 @@ func TestComparableList_EndsWith(t *testing.T) {
 		})
 	}
 }
+
+func TestNewComparableListOf(t *testing.T) {
+	l := NewComparableListOf(3, 1, 2)
+	if !slices.Equal(l.ToSlice(), []int{3, 1, 2}) || l.Sum() != 6 {
+		t.Errorf("NewComparableListOf() = %v, want %v", l.ToSlice(), []int{3, 1, 2})
+	}
+}
+
+func TestComparableList_ReplaceAll(t *testing.T) {
+	l := NewComparableList([]string{"a", "b", "a", "c"})
+	n := l.ReplaceAll("a", "z")
+	if got := l.ToSlice(); n != 2 || !slices.Equal(got, []string{"z", "b", "z", "c"}) {
+		t.Errorf("ReplaceAll() = %v %v, want %v %v", n, got, 2, []string{"z", "b", "z", "c"})
+	}
+}
+
+func TestComparableList_ApplyCount(t *testing.T) {
+	l := NewComparableList([]int{-2, 0, 3, -5})
+	abs := func(i int) int { return max(i, -i) }
+	if n := l.ApplyCount(abs); n != 2 || !slices.Equal(l.ToSlice(), []int{2, 0, 3, 5}) {
+		t.Errorf("ApplyCount() = %v %v, want %v %v", n, l.ToSlice(), 2, []int{2, 0, 3, 5})
+	}
+	if n := l.ApplyCount(abs); n != 0 {
+		t.Errorf("ApplyCount() = %v on a no-op pass, want %v", n, 0)
+	}
+	n := l.ApplyWhereCount(func(i int) bool { return i > 2 }, func(i int) int { return i * 10 })
+	if n != 2 || !slices.Equal(l.ToSlice(), []int{2, 0, 30, 50}) {
+		t.Errorf("ApplyWhereCount() = %v %v, want %v %v", n, l.ToSlice(), 2, []int{2, 0, 30, 50})
+	}
+}
+
+func TestComparableList_EqualsRotated(t *testing.T) {
+	l := NewComparableList([]int{1, 2, 3})
+	if !l.EqualsRotated(NewComparableList([]int{2, 3, 1})) {
+		t.Errorf("EqualsRotated() = %v, want %v", false, true)
+	}
+	if l.EqualsRotated(NewComparableList([]int{2, 1, 3})) {
+		t.Errorf("EqualsRotated() = %v, want %v", true, false)
+	}
+}
+
+func TestComparableList_ImplementsMutableCollection(t *testing.T) {
+	var c collection.MutableCollection[int] = NewComparableList([]int{1, 2, 3, 2})
+	c.Remove(2)
+	if got := c.(*ComparableList[int]).ToSlice(); !slices.Equal(got, []int{1, 3, 2}) {
+		t.Errorf("Remove() = %v, want %v", got, []int{1, 3, 2})
+	}
+	c.AddAll(4, 5, 6)
+	c.RemoveWhere(func(i int) bool { return i%2 == 0 })
+	if got := c.(*ComparableList[int]).ToSlice(); !slices.Equal(got, []int{1, 3, 5}) {
+		t.Errorf("RemoveWhere() = %v, want %v", got, []int{1, 3, 5})
+	}
+	c.Clear()
+	if c.Length() != 0 {
+		t.Errorf("Clear() left %v elements, want %v", c.Length(), 0)
+	}
+}
+
+func TestComparableList_Remove(t *testing.T) {
+	l := NewComparableList([]int{1, 2, 3, 2})
+	if !l.Remove(2) {
+		t.Errorf("Remove() = %v, want %v", false, true)
+	}
+	if l.Remove(4) {
+		t.Errorf("Remove() of a missing value = %v, want %v", true, false)
+	}
+	if got := l.RemoveWhere(func(i int) bool { return i > 1 }); got != 2 {
+		t.Errorf("RemoveWhere() = %v, want %v", got, 2)
+	}
+	if !slices.Equal(l.ToSlice(), []int{1}) {
+		t.Errorf("Remove() = %v, want %v", l.ToSlice(), []int{1})
+	}
+}
+
+func TestList_ImplementsRemovableCollection(t *testing.T) {
+	var _ collection.RemovableCollection[int] = NewList[int]()
+	var _ collection.MutableCollection[int] = collection.WithRemove[int](NewList[int]())
+
+	// lists of values that are not comparable with == are adapted with an equality function.
+	l := NewList([][]int{{1}, {2}, {1}})
+	var c collection.MutableCollection[[]int] = collection.WithRemoveFunc[[]int](l, slices.Equal[[]int])
+	if !c.Remove([]int{1}) || c.Remove([]int{3}) {
+		t.Errorf("Remove() did not report the removals")
+	}
+	if got := l.ToSlice(); len(got) != 2 || got[0][0] != 2 || got[1][0] != 1 {
+		t.Errorf("Remove() = %v, want %v", got, [][]int{{2}, {1}})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// functions.go defines functions that map collections to Lists and generate Lists.
+
+package list
+
+import "github.com/charbz/gophers/collection"
+
+// MapTo applies a mapping function to each element of the collection
+// and returns a new list of the results.
+//
+// example usage:
+//
+//	names := NewList([]string{"Alice", "Bob", "Charlie"})
+//	MapTo(names, func(name string) int {
+//	  return len(name)
+//	})
+//
+// output:
+//
+//	List(int) [5 3 7]
+func MapTo[T, K any](s collection.Collection[T], f func(T) K) *List[K] {
+	l := NewList[K]()
+	for v := range s.Values() {
+		l.Add(f(v))
+	}
+	return l
+}
+
+// Gen returns a new list of n elements where the i-th element is f(i).
+// It panics with an InvalidArgumentError if n is negative.
+//
+// example usage:
+//
+//	Gen(4, func(i int) string { return strconv.Itoa(i) })
+//
+// output:
+//
+//	List(string) [0 1 2 3]
+func Gen[T any](n int, f func(int) T) *List[T] {
+	if n < 0 {
+		panic(collection.InvalidArgumentError)
+	}
+	l := NewList[T]()
+	for i := range n {
+		l.Add(f(i))
+	}
+	return l
+}
+
+// GenParallel is like Gen but calls f from the given number of goroutines,
+// see collection.GenerateParallel. f must be safe for concurrent use.
+func GenParallel[T any](n, workers int, f func(int) T) *List[T] {
+	return NewList(collection.GenerateParallel(n, workers, f))
+}
+
+// WithIndex returns a new list pairing every element of the collection with its position
+// in iteration order, so that positions survive chained transformations. Use
+// collection.KeepIndex to map the values while keeping their positions.
+//
+// example usage:
+//
+//	c := NewList([]int{5,6,7,8})
+//	WithIndex(c).Filter(func(e collection.Indexed[int]) bool { return e.Value % 2 == 0 })
+//
+// output:
+//
+//	List(collection.Indexed[int]) [{1 6} {3 8}]
+func WithIndex[T any](s collection.Collection[T]) *List[collection.Indexed[T]] {
+	l := NewList[collection.Indexed[T]]()
+	i := 0
+	for v := range s.Values() {
+		l.Add(collection.Indexed[T]{Index: i, Value: v})
+		i++
+	}
+	return l
+}
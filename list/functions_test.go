@@ -0,0 +1,37 @@
+package list
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/charbz/gophers/collection"
+)
+
+func TestMapTo(t *testing.T) {
+	got := MapTo(NewList([]string{"Alice", "Bob", "Charlie"}), func(s string) int { return len(s) })
+	if !slices.Equal(got.ToSlice(), []int{5, 3, 7}) {
+		t.Errorf("MapTo() = %v, want %v", got.ToSlice(), []int{5, 3, 7})
+	}
+	if got.Length() != 3 {
+		t.Errorf("MapTo() Length() = %v, want %v", got.Length(), 3)
+	}
+}
+
+func TestGen(t *testing.T) {
+	square := func(i int) int { return i * i }
+	want := []int{0, 1, 4, 9}
+	if got := Gen(4, square); !slices.Equal(got.ToSlice(), want) {
+		t.Errorf("Gen() = %v, want %v", got.ToSlice(), want)
+	}
+	if got := GenParallel(4, 0, square); !slices.Equal(got.ToSlice(), want) {
+		t.Errorf("GenParallel() = %v, want %v", got.ToSlice(), want)
+	}
+}
+
+func TestWithIndex(t *testing.T) {
+	got := WithIndex(NewList([]int{5, 6, 7, 8})).Filter(func(e collection.Indexed[int]) bool { return e.Value%2 == 0 })
+	want := []collection.Indexed[int]{{Index: 1, Value: 6}, {Index: 3, Value: 8}}
+	if !slices.Equal(got.ToSlice(), want) {
+		t.Errorf("WithIndex() = %v, want %v", got.ToSlice(), want)
+	}
+}
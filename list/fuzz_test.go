@@ -0,0 +1,97 @@
+package list
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/charbz/gophers/gopherstest"
+)
+
+// fuzzValue maps fuzz input bytes to a small range of values so that duplicates are common.
+func fuzzValue(b byte) int { return int(b % 8) }
+
+func FuzzList_Mutations(f *testing.F) {
+	f.Add([]byte{0, 1, 1, 2, 0, 1, 2, 1, 3, 2, 4, 0, 0, 3})
+	f.Add([]byte{1, 255, 1, 7, 2, 7, 3, 127})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		l := NewComparableList[int]()
+		if gopherstest.AssertSameMutations(t, data, l, gopherstest.NewSliceModel[int](nil), fuzzValue, true) {
+			// the links must be consistent in both directions after any mutation.
+			var backward []int
+			for _, v := range l.Backward() {
+				backward = append(backward, v)
+			}
+			slices.Reverse(backward)
+			if !slices.Equal(backward, l.ToSlice()) {
+				t.Errorf("Backward() = %v, want the reverse of %v", backward, l.ToSlice())
+			}
+		}
+	})
+}
+
+// FuzzList_Operations compares the operations of a list with
+// reference implementations over a plain slice.
+func FuzzList_Operations(f *testing.F) {
+	f.Add([]byte{3, 1, 4, 1, 5, 9, 2, 6}, 3)
+	f.Add([]byte{}, 0)
+	f.Add([]byte{7, 7, 7}, -2)
+	f.Fuzz(func(t *testing.T, data []byte, n int) {
+		s := make([]int, len(data))
+		for i, b := range data {
+			s[i] = fuzzValue(b)
+		}
+		l := NewList(s)
+		k := min(max(n, 0), len(s))
+		even := func(i int) bool { return i%2 == 0 }
+
+		check := func(op string, got, want []int) {
+			t.Helper()
+			if !slices.Equal(got, want) {
+				t.Errorf("%s on %v = %v, want %v", op, s, got, want)
+			}
+		}
+		check("Take", l.Take(n).ToSlice(), s[:k])
+		check("TakeRight", l.TakeRight(n).ToSlice(), s[len(s)-k:])
+		check("Drop", l.Drop(n).ToSlice(), s[k:])
+		check("DropRight", l.DropRight(n).ToSlice(), s[:len(s)-k])
+		check("Filter", l.Filter(even).ToSlice(), slices.DeleteFunc(slices.Clone(s), func(i int) bool { return !even(i) }))
+		check("Reverse", l.Reverse().ToSlice(), reversed(s))
+		check("Distinct", l.Distinct(func(a, b int) bool { return a == b }).ToSlice(), distinct(s))
+		check("ComparableList.Distinct", NewComparableList(s).Distinct().ToSlice(), distinct(s))
+		// the SplitAt method keeps the value at index n in the left list, clamping n
+		// to [-1, len-1], while SplitAtSafe puts n values in the left list as
+		// collection.SplitAtSafe does, rejecting n out of [0, len].
+		i := min(max(n, -1), len(s)-1) + 1
+		left, right := l.SplitAt(n)
+		check("SplitAt left", left.ToSlice(), s[:i])
+		check("SplitAt right", right.ToSlice(), s[i:])
+		if lo, hi, err := l.SplitAtSafe(n); err != nil {
+			if n >= 0 && n <= len(s) {
+				t.Errorf("SplitAtSafe(%v) on %v error = %v", n, s, err)
+			}
+		} else {
+			check("SplitAtSafe left", lo.ToSlice(), s[:n])
+			check("SplitAtSafe right", hi.ToSlice(), s[n:])
+		}
+		if !slices.Equal(l.ToSlice(), s) {
+			t.Errorf("operations modified the list: %v, want %v", l.ToSlice(), s)
+		}
+	})
+}
+
+func reversed(s []int) []int {
+	r := slices.Clone(s)
+	slices.Reverse(r)
+	return r
+}
+
+// distinct returns the first occurrence of each value of the slice.
+func distinct(s []int) []int {
+	var r []int
+	for _, v := range s {
+		if !slices.Contains(r, v) {
+			r = append(r, v)
+		}
+	}
+	return r
+}
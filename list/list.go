@@ -26,6 +26,7 @@ type Node[T any] struct {
 	value T
 	next  *Node[T]
 	prev  *Node[T]
+	list  *List[T] // the list holding the node, nil once removed
 }
 
 type List[T any] struct {
@@ -47,21 +48,17 @@ func NewList[T any](s ...[]T) *List[T] {
 	return list
 }
 
+// NewListOf is a constructor for a list holding the given values.
+func NewListOf[T any](v ...T) *List[T] {
+	return NewList(v)
+}
+
 // The following methods implement
 // the Collection interface.
 
 // Add adds a value to the end of the list.
 func (l *List[T]) Add(v T) {
-	node := &Node[T]{value: v}
-	if l.head == nil {
-		l.head = node
-		l.tail = node
-	} else {
-		l.tail.next = node
-		node.prev = l.tail
-		l.tail = node
-	}
-	l.size++
+	l.linkBack(&Node[T]{value: v})
 }
 
 // Length returns the number of nodes in the list.
@@ -75,6 +72,9 @@ func (l *List[T]) New(s ...[]T) collection.Collection[T] {
 }
 
 // Random returns a random value from the list.
+//
+// Deprecated: Random returns the zero value when the list is empty. Use Choice instead,
+// which returns an EmptyCollectionError for every collection type.
 func (l *List[T]) Random() T {
 	if l.size == 0 {
 		return *new(T)
@@ -82,6 +82,11 @@ func (l *List[T]) Random() T {
 	return l.At(rand.Intn(l.size))
 }
 
+// Choice is an alias for collection.Choice
+func (l *List[T]) Choice() (T, error) {
+	return collection.Choice(l)
+}
+
 // Values returns an iterator for all values in the list.
 func (l *List[T]) Values() iter.Seq[T] {
 	return func(yield func(T) bool) {
@@ -159,13 +164,114 @@ func (l *List[T]) NewOrdered(s ...[]T) collection.OrderedCollection[T] {
 	return NewList(s...)
 }
 
+// The following methods implement
+// the MutableCollection interface.
+
+// AddAll appends all the given values to the end of the list.
+func (l *List[T]) AddAll(v ...T) {
+	for _, value := range v {
+		l.Add(value)
+	}
+}
+
+// Clear removes all nodes from the list.
+func (l *List[T]) Clear() {
+	for node := l.head; node != nil; node = node.next {
+		node.list = nil
+	}
+	l.head = nil
+	l.tail = nil
+	l.size = 0
+}
+
+// RemoveWhere removes all nodes whose value satisfies the predicate
+// and returns the number of nodes removed.
+func (l *List[T]) RemoveWhere(f func(T) bool) int {
+	count := 0
+	for node := l.head; node != nil; {
+		next := node.next
+		if f(node.value) {
+			l.unlink(node)
+			count++
+		}
+		node = next
+	}
+	return count
+}
+
+// unlink detaches a node from the list.
+func (l *List[T]) unlink(node *Node[T]) {
+	if node.prev == nil {
+		l.head = node.next
+	} else {
+		node.prev.next = node.next
+	}
+	if node.next == nil {
+		l.tail = node.prev
+	} else {
+		node.next.prev = node.prev
+	}
+	node.next = nil
+	node.prev = nil
+	node.list = nil
+	l.size--
+}
+
+// linkBack attaches a detached node to the end of the list.
+func (l *List[T]) linkBack(node *Node[T]) {
+	if l.head == nil {
+		l.head = node
+	} else {
+		l.tail.next = node
+		node.prev = l.tail
+	}
+	l.tail = node
+	node.list = l
+	l.size++
+}
+
+// Value returns the value of the node.
+func (n *Node[T]) Value() T {
+	return n.value
+}
+
+// AddNode adds a value to the end of the list and returns its node. The node gives
+// constant time access to the value for MoveToBack and RemoveNode, which allows
+// building structures such as caches on top of a list.
+func (l *List[T]) AddNode(v T) *Node[T] {
+	node := &Node[T]{value: v}
+	l.linkBack(node)
+	return node
+}
+
+// MoveToBack moves a node of the list to the end of the list.
+// The list is not modified if the node does not belong to it.
+func (l *List[T]) MoveToBack(node *Node[T]) {
+	if node.list != l || node == l.tail {
+		return
+	}
+	l.unlink(node)
+	l.linkBack(node)
+}
+
+// RemoveNode removes a node from the list and returns its value.
+// The list is not modified if the node does not belong to it,
+// for example if it was already removed.
+func (l *List[T]) RemoveNode(node *Node[T]) T {
+	if node.list == l {
+		l.unlink(node)
+	}
+	return node.value
+}
+
 // ToSlice returns a slice containing all values in the list.
 func (l *List[T]) ToSlice() []T {
-	slice := make([]T, 0, l.size)
-	for v := range l.Values() {
-		slice = append(slice, v)
-	}
-	return slice
+	return l.AppendToSlice(make([]T, 0, l.size))
+}
+
+// AppendToSlice is an alias for collection.AppendToSlice
+func (l *List[T]) AppendToSlice(dst []T) []T {
+	return collection.AppendToSlice(l, dst)
 }
 
 // Implement the Stringer interface.
@@ -186,6 +292,131 @@ func (l *List[T]) Apply(f func(T) T) *List[T] {
 	return l
 }
 
+// ApplyChanged applies a function to each element in the list and returns the number
+// of elements changed, which allows callers to detect passes that changed nothing.
+// The function returns the new value and whether it differs from the original,
+// elements for which it returns false are left untouched.
+func (l *List[T]) ApplyChanged(f func(T) (T, bool)) int {
+	n := 0
+	for node := l.head; node != nil; node = node.next {
+		if v, changed := f(node.value); changed {
+			node.value = v
+			n++
+		}
+	}
+	return n
+}
+
+// ApplyIndexed applies a function to each element and its index in the list.
+func (l *List[T]) ApplyIndexed(f func(int, T) T) *List[T] {
+	i := 0
+	for node := l.head; node != nil; node = node.next {
+		node.value = f(i, node.value)
+		i++
+	}
+	return l
+}
+
+// ApplyWhere applies a function to each element in the list
+// that satisfies the predicate, other elements are left untouched.
+func (l *List[T]) ApplyWhere(p func(T) bool, f func(T) T) *List[T] {
+	for node := l.head; node != nil; node = node.next {
+		if p(node.value) {
+			node.value = f(node.value)
+		}
+	}
+	return l
+}
+
+// ApplyWhereIndexed is similar to ApplyWhere but passes the index of each element
+// to both the predicate and the function.
+func (l *List[T]) ApplyWhereIndexed(p func(int, T) bool, f func(int, T) T) *List[T] {
+	i := 0
+	for node := l.head; node != nil; node = node.next {
+		if p(i, node.value) {
+			node.value = f(i, node.value)
+		}
+		i++
+	}
+	return l
+}
+
+// FillRange sets the values from the start index to the end index (exclusive) to v.
+// It panics with an IndexOutOfBoundsError if the range is invalid.
+func (l *List[T]) FillRange(start, end int, v T) *List[T] {
+	if start < 0 || end > l.size || start > end {
+		panic(collection.IndexOutOfBoundsError)
+	}
+	node := l.head
+	for i := 0; i < end; i++ {
+		if i >= start {
+			node.value = v
+		}
+		node = node.next
+	}
+	return l
+}
+
+// RemoveRange removes the nodes from the start index to the end index (exclusive),
+// unlinking them from the list.
+// It panics with an IndexOutOfBoundsError if the range is invalid.
+func (l *List[T]) RemoveRange(start, end int) *List[T] {
+	if start < 0 || end > l.size || start > end {
+		panic(collection.IndexOutOfBoundsError)
+	}
+	node := l.head
+	for i := 0; i < end; i++ {
+		next := node.next
+		if i >= start {
+			l.unlink(node)
+		}
+		node = next
+	}
+	return l
+}
+
+// RetainRange keeps only the nodes from the start index to the end index (exclusive),
+// unlinking all the others from the list.
+// It panics with an IndexOutOfBoundsError if the range is invalid.
+func (l *List[T]) RetainRange(start, end int) *List[T] {
+	if start < 0 || end > l.size || start > end {
+		panic(collection.IndexOutOfBoundsError)
+	}
+	node := l.head
+	for i := 0; node != nil; i++ {
+		next := node.next
+		if i < start || i >= end {
+			l.unlink(node)
+		}
+		node = next
+	}
+	return l
+}
+
+// ReplaceAllFunc replaces every value that satisfies the predicate with v
+// and returns the number of values replaced.
+func (l *List[T]) ReplaceAllFunc(p func(T) bool, v T) int {
+	n := 0
+	for node := l.head; node != nil; node = node.next {
+		if p(node.value) {
+			node.value = v
+			n++
+		}
+	}
+	return n
+}
+
+// Chunks is an alias for collection.Chunks
+func (l *List[T]) Chunks(size int) iter.Seq2[collection.PageInfo, *List[T]] {
+	return func(yield func(collection.PageInfo, *List[T]) bool) {
+		for info, chunk := range collection.Chunks(l, size) {
+			if !yield(info, collection.MustCast[*List[T]]("Chunks", chunk)) {
+				return
+			}
+		}
+	}
+}
+
 // Clone returns a copy of the list. This is a shallow clone.
 func (l *List[T]) Clone() *List[T] {
 	clone := &List[T]{}
@@ -195,6 +426,51 @@ func (l *List[T]) Clone() *List[T] {
 	return clone
 }
 
+// Snapshot is a copy of the values of a list taken by List.Snapshot,
+// which can be restored any number of times with List.RestoreFrom.
+type Snapshot[T any] struct {
+	values []T
+}
+
+// Snapshot returns a shallow copy of the values of the list, allowing speculative
+// algorithms such as backtracking searches to revert the list to its current state
+// with RestoreFrom. Mutating the list does not affect the snapshot.
+//
+// example usage:
+//
+//	l := NewList([]int{1,2,3})
+//	s := l.Snapshot()
+//	l.Pop()
+//	l.RestoreFrom(s)
+//
+// output:
+//
+//	List(int) [1 2 3]
+func (l *List[T]) Snapshot() Snapshot[T] {
+	return Snapshot[T]{values: l.ToSlice()}
+}
+
+// RestoreFrom replaces the values of the list with the values of the snapshot.
+// It reuses the existing nodes of the list, allocating only the nodes needed beyond
+// its current length, and leaves the snapshot unchanged.
+func (l *List[T]) RestoreFrom(s Snapshot[T]) *List[T] {
+	node := l.head
+	for i, v := range s.values {
+		if node == nil {
+			l.AddAll(s.values[i:]...)
+			return l
+		}
+		node.value = v
+		node = node.next
+	}
+	for node != nil {
+		next := node.next
+		l.unlink(node)
+		node = next
+	}
+	return l
+}
+
 // Count is an alias for collection.Count
 func (l *List[T]) Count(f func(T) bool) int {
 	return collection.Count(l, f)
@@ -233,14 +509,27 @@ func (l *List[T]) Dequeue() (T, error) {
 		return *new(T), collection.EmptyCollectionError
 	}
 	element := l.head.value
-	l.head = l.head.next
-	l.size--
+	l.unlink(l.head)
 	return element, nil
 }
 
+// Drain returns an iterator that dequeues elements from the front of the list as it yields them.
+// Elements added to the list while draining are also yielded, iteration ends once the list is empty.
+// If iteration stops early, the remaining elements stay in the list.
+func (l *List[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for l.size > 0 {
+			v, _ := l.Dequeue()
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
 // Diff is an alias for collection.DiffFunc
 func (l *List[T]) Diff(s *List[T], f func(T, T) bool) *List[T] {
-	return collection.DiffFunc(l, s, f).(*List[T])
+	return collection.MustCast[*List[T]]("Diff", collection.DiffFunc(l, s, f))
 }
 
 // Diffed is an alias for collection.Diffed
@@ -253,7 +542,7 @@ func (l *List[T]) Diffed(s *List[T], f func(T, T) bool) iter.Seq[T] {
 // and returns a new sequence containing all the unique elements.
 // If you don't want to pass an equality function use a ComparableList.
 func (l *List[T]) Distinct(f func(T, T) bool) *List[T] {
-	return collection.Distinct(l, f).(*List[T])
+	return collection.MustCast[*List[T]]("Distinct", collection.Distinct(l, f))
 }
 
 // Distincted is an alias for collection.Distincted
@@ -263,17 +552,24 @@ func (l *List[T]) Distincted(f func(T, T) bool) iter.Seq[T] {
 
 // Drop is an alias for collection.Drop
 func (l *List[T]) Drop(n int) *List[T] {
-	return collection.Drop(l, n).(*List[T])
+	return collection.MustCast[*List[T]]("Drop", collection.Drop(l, n))
 }
 
 // DropWhile is an alias for collection.DropWhile
 func (l *List[T]) DropWhile(f func(T) bool) *List[T] {
-	return collection.DropWhile(l, f).(*List[T])
+	return collection.MustCast[*List[T]]("DropWhile", collection.DropWhile(l, f))
 }
 
 // DropRight is an alias for collection.DropRight
 func (l *List[T]) DropRight(n int) *List[T] {
-	return collection.DropRight(l, n).(*List[T])
+	return collection.MustCast[*List[T]]("DropRight", collection.DropRight(l, n))
+}
+
+// EndsWith takes a list and an equality function as an argument
+// and returns true if the list ends with the given list.
+// If you prefer not to pass an equality function use a ComparableList.
+func (l *List[T]) EndsWith(other *List[T], f func(T, T) bool) bool {
+	return collection.EndsWithFunc(l, other, f)
 }
 
 // Enqueue appends an element to the list.
@@ -281,6 +577,11 @@ func (l *List[T]) Enqueue(v T) {
 	l.Add(v)
 }
 
+// EqualsDeep is an alias for collection.EqualsDeep
+func (l *List[T]) EqualsDeep(s *List[T], comparers ...collection.DeepComparer) bool {
+	return collection.EqualsDeep(l, s, comparers...)
+}
+
 // Equals takes a list and an equality function as arguments
 // and returns true if the two sequences are equal.
 // If you prefer not to pass an equality function use a ComparableList.
@@ -307,7 +608,7 @@ func (l *List[T]) Exists(f func(T) bool) bool {
 
 // Filter is an alias for collection.Filter
 func (l *List[T]) Filter(f func(T) bool) *List[T] {
-	return collection.Filter(l, f).(*List[T])
+	return collection.MustCast[*List[T]]("Filter", collection.Filter(l, f))
 }
 
 // Filtered is an alias for collection.Filtered
@@ -317,7 +618,7 @@ func (l *List[T]) Filtered(f func(T) bool) iter.Seq[T] {
 
 // FilterNot is an alias for collection.FilterNot
 func (l *List[T]) FilterNot(f func(T) bool) *List[T] {
-	return collection.FilterNot(l, f).(*List[T])
+	return collection.MustCast[*List[T]]("FilterNot", collection.FilterNot(l, f))
 }
 
 // Find is an alias for collection.Find
@@ -325,29 +626,61 @@ func (l *List[T]) Find(f func(T) bool) (int, T) {
 	return collection.Find(l, f)
 }
 
+// FindAll is an alias for collection.FindAll
+func (l *List[T]) FindAll(f func(T) bool) ([]int, *List[T]) {
+	indices, values := collection.FindAll(l, f)
+	return indices, collection.MustCast[*List[T]]("FindAll", values)
+}
+
 // FindLast is an alias for collection.FindLast
 func (l *List[T]) FindLast(f func(T) bool) (int, T) {
 	return collection.FindLast(l, f)
 }
 
+// FindOr is an alias for collection.FindOr
+func (l *List[T]) FindOr(f func(T) bool, def T) T {
+	return collection.FindOr(l, f, def)
+}
+
+// ForEachWhile is an alias for collection.ForEachWhile
+func (l *List[T]) ForEachWhile(f func(T) bool) bool {
+	return collection.ForEachWhile(l, f)
+}
+
 // ForAll is an alias for collection.ForAll
 func (l *List[T]) ForAll(f func(T) bool) bool {
 	return collection.ForAll(l, f)
 }
 
+// Freeze returns a read-only view of the list.
+func (l *List[T]) Freeze() collection.ReadOnlyOrderedCollection[T] {
+	return collection.FreezeOrdered(l)
+}
+
 // Head is an alias for collection.Head
 func (l *List[T]) Head() (T, error) {
 	return collection.Head(l)
 }
 
+// HeadOr is an alias for collection.HeadOr
+func (l *List[T]) HeadOr(def T) T {
+	return collection.HeadOr(l, def)
+}
+
 // Init is an alias for collection.Init
 func (l *List[T]) Init() *List[T] {
-	return collection.Init(l).(*List[T])
+	return collection.MustCast[*List[T]]("Init", collection.Init(l))
+}
+
+// InspectEach is an alias for collection.InspectEach
+func (l *List[T]) InspectEach(f func(int, T)) *List[T] {
+	collection.InspectEach(l, f)
+	return l
 }
 
 // Intersect is an alias for collection.IntersectFunc
 func (l *List[T]) Intersect(s *List[T], f func(T, T) bool) *List[T] {
-	return collection.IntersectFunc(l, s, f).(*List[T])
+	return collection.MustCast[*List[T]]("Intersect", collection.IntersectFunc(l, s, f))
 }
 
 // Intersected is an alias for collection.Intersected
@@ -365,6 +698,31 @@ func (l *List[T]) Last() (T, error) {
 	return collection.Last(l)
 }
 
+// LastOr is an alias for collection.LastOr
+func (l *List[T]) LastOr(def T) T {
+	return collection.LastOr(l, def)
+}
+
+// MergeDistinctSorted is an alias for collection.MergeDistinctSorted
+func (l *List[T]) MergeDistinctSorted(s *List[T], less func(T, T) bool) *List[T] {
+	return collection.MustCast[*List[T]]("MergeDistinctSorted", collection.MergeDistinctSorted(l, s, less))
+}
+
+// MergeSorted is an alias for collection.MergeSorted
+func (l *List[T]) MergeSorted(s *List[T], less func(T, T) bool) *List[T] {
+	return collection.MustCast[*List[T]]("MergeSorted", collection.MergeSorted(l, s, less))
+}
+
+// MismatchIndex is an alias for collection.MismatchIndex
+func (l *List[T]) MismatchIndex(s *List[T], f func(T, T) bool) int {
+	return collection.MismatchIndex(l, s, f)
+}
+
+// None is an alias for collection.None
+func (l *List[T]) None(f func(T) bool) bool {
+	return collection.None(l, f)
+}
+
 // NonEmpty returns true if the list is not empty.
 func (l *List[T]) NonEmpty() bool {
 	return l.size > 0
@@ -376,8 +734,7 @@ func (l *List[T]) Pop() (T, error) {
 		return *new(T), collection.EmptyCollectionError
 	}
 	element := l.tail.value
-	l.tail = l.tail.prev
-	l.size--
+	l.unlink(l.tail)
 	return element, nil
 }
 
@@ -386,13 +743,60 @@ func (l *List[T]) Push(v T) {
 	l.Add(v)
 }
 
+// Pairwise is an alias for collection.Pairwise
+func (l *List[T]) Pairwise() iter.Seq2[T, T] {
+	return collection.Pairwise(l)
+}
+
+// Page is an alias for collection.Page
+func (l *List[T]) Page(index, size int) (*List[T], collection.PageInfo) {
+	page, info := collection.Page(l, index, size)
+	return collection.MustCast[*List[T]]("Page", page), info
+}
+
 // Partition is an alias for collection.Partition
 func (l *List[T]) Partition(f func(T) bool) (*List[T], *List[T]) {
 	left, right := collection.Partition(l, f)
-	return left.(*List[T]), right.(*List[T])
+	return collection.MustCast[*List[T]]("Partition", left), collection.MustCast[*List[T]]("Partition", right)
 }
 
-// SplitAt splits the list at the given index.
+// Shard is an alias for collection.Shard
+func (l *List[T]) Shard(n int) []*List[T] {
+	shards := collection.Shard(l, n)
+	result := make([]*List[T], len(shards))
+	for i, shard := range shards {
+		result[i] = collection.MustCast[*List[T]]("Shard", shard)
+	}
+	return result
+}
+
+// SliceStep is an alias for collection.SliceStep
+func (l *List[T]) SliceStep(start, end, step int) *List[T] {
+	return collection.MustCast[*List[T]]("SliceStep", collection.SliceStep(l, start, end, step))
+}
+
+// SliceSafe is an alias for collection.SliceSafe
+func (l *List[T]) SliceSafe(start, end int) (*List[T], error) {
+	s, err := collection.SliceSafe(l, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return collection.Cast[*List[T]]("SliceSafe", s)
+}
+
+// SplitAtSafe is an alias for collection.SplitAtSafe. Unlike SplitAt, the left list
+// holds the first n elements and n must be between 0 and Length().
+func (l *List[T]) SplitAtSafe(n int) (*List[T], *List[T], error) {
+	left, right, err := collection.SplitAtSafe(l, n)
+	if err != nil {
+		return nil, nil, err
+	}
+	return collection.MustCast[*List[T]]("SplitAtSafe", left), collection.MustCast[*List[T]]("SplitAtSafe", right), nil
+}
+
+// SplitAt splits the list at the given index, the value at index n being part
+// of the left list. n is clamped to the range [-1, Length()-1], so that a negative
+// n leaves the left list empty and n >= Length()-1 leaves the right list empty.
 func (l *List[T]) SplitAt(n int) (*List[T], *List[T]) {
 	left := NewList[T]()
 	right := NewList[T]()
@@ -408,16 +812,16 @@ func (l *List[T]) SplitAt(n int) (*List[T], *List[T]) {
 
 // Reverse is an alias for collection.Reverse
 func (l *List[T]) Reverse() *List[T] {
-	return collection.Reverse(l).(*List[T])
+	return collection.MustCast[*List[T]]("Reverse", collection.Reverse(l))
 }
 
 func (l *List[T]) Shuffle() *List[T] {
-	return collection.Shuffle(l).(*List[T])
+	return collection.MustCast[*List[T]]("Shuffle", collection.Shuffle(l))
 }
 
 // Reject is an alias for collection.FilterNot
 func (l *List[T]) Reject(f func(T) bool) *List[T] {
-	return collection.FilterNot(l, f).(*List[T])
+	return collection.MustCast[*List[T]]("Reject", collection.FilterNot(l, f))
 }
 
 // Rejected is an alias for collection.Rejected
@@ -425,17 +829,30 @@ func (l *List[T]) Rejected(f func(T) bool) iter.Seq[T] {
 	return collection.Rejected(l, f)
 }
 
+// Tap is an alias for collection.Tap
+func (l *List[T]) Tap(f func(collection.Collection[T])) *List[T] {
+	collection.Tap(l, f)
+	return l
+}
+
+// StartsWith takes a list and an equality function as an argument
+// and returns true if the list starts with the given list.
+// If you prefer not to pass an equality function use a ComparableList.
+func (l *List[T]) StartsWith(other *List[T], f func(T, T) bool) bool {
+	return collection.StartsWithFunc(l, other, f)
+}
+
 // Take is an alias for collection.Take
 func (l *List[T]) Take(n int) *List[T] {
-	return collection.Take(l, n).(*List[T])
+	return collection.MustCast[*List[T]]("Take", collection.Take(l, n))
 }
 
 // TakeRight is an alias for collection.TakeRight
 func (l *List[T]) TakeRight(n int) *List[T] {
-	return collection.TakeRight(l, n).(*List[T])
+	return collection.MustCast[*List[T]]("TakeRight", collection.TakeRight(l, n))
 }
 
 // Tail is an alias for collection.Tail
 func (l *List[T]) Tail() *List[T] {
-	return collection.Tail(l).(*List[T])
+	return collection.MustCast[*List[T]]("Tail", collection.Tail(l))
 }
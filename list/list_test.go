@@ -4,6 +4,8 @@ import (
 	"reflect"
 	"slices"
 	"testing"
+
+	"github.com/charbz/gophers/collection"
 )
 
 func TestList_Head(t *testing.T) {
@@ -707,6 +709,34 @@ func TestList_Reject(t *testing.T) {
 	}
 }
 
+func TestList_SnapshotRestore(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(l *List[int])
+	}{
+		{name: "grown", mutate: func(l *List[int]) { l.AddAll(4, 5) }},
+		{name: "shrunk", mutate: func(l *List[int]) { l.Pop() }},
+		{name: "cleared", mutate: func(l *List[int]) { l.Clear() }},
+		{name: "changed in place", mutate: func(l *List[int]) { l.Apply(func(i int) int { return -i }) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewList([]int{1, 2, 3})
+			snap := l.Snapshot()
+			for range 2 {
+				tt.mutate(l)
+				l.RestoreFrom(snap)
+				if got := l.ToSlice(); !slices.Equal(got, []int{1, 2, 3}) || l.Length() != 3 {
+					t.Errorf("RestoreFrom() = %v with length %v, want %v", got, l.Length(), []int{1, 2, 3})
+				}
+				if v, _ := l.Last(); v != 3 {
+					t.Errorf("Last() = %v after RestoreFrom(), want %v", v, 3)
+				}
+			}
+		})
+	}
+}
+
 func TestList_SplitAt(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -949,3 +979,425 @@ func TestList_ShuffleDistribution(t *testing.T) {
 		}
 	}
 }
+
+func TestList_ApplyWhere(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice []int
+		pred  func(int) bool
+		want  []int
+	}{
+		{
+			name:  "apply to even elements",
+			slice: []int{1, 2, 3, 4},
+			pred:  func(i int) bool { return i%2 == 0 },
+			want:  []int{1, 20, 3, 40},
+		},
+		{
+			name:  "no matching elements",
+			slice: []int{1, 3},
+			pred:  func(i int) bool { return i%2 == 0 },
+			want:  []int{1, 3},
+		},
+		{
+			name:  "empty list",
+			slice: []int{},
+			pred:  func(i int) bool { return true },
+			want:  []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewList(tt.slice)
+			l.ApplyWhere(tt.pred, func(i int) int { return i * 10 })
+			if !slices.Equal(l.ToSlice(), tt.want) {
+				t.Errorf("ApplyWhere() = %v, want %v", l.ToSlice(), tt.want)
+			}
+		})
+	}
+}
+
+func TestList_ApplyChanged(t *testing.T) {
+	l := NewList([]int{1, 2, 3, 4})
+	roundUp := func(i int) (int, bool) {
+		if i%2 == 0 {
+			return i, false
+		}
+		return i + 1, true
+	}
+	if n := l.ApplyChanged(roundUp); n != 2 || !slices.Equal(l.ToSlice(), []int{2, 2, 4, 4}) {
+		t.Errorf("ApplyChanged() = %v %v, want %v %v", n, l.ToSlice(), 2, []int{2, 2, 4, 4})
+	}
+	if n := l.ApplyChanged(roundUp); n != 0 {
+		t.Errorf("ApplyChanged() = %v on a no-op pass, want %v", n, 0)
+	}
+}
+
+func TestList_ApplyIndexed(t *testing.T) {
+	l := NewList([]int{5, 5, 5})
+	l.ApplyIndexed(func(i int, v int) int { return v + i })
+	if !slices.Equal(l.ToSlice(), []int{5, 6, 7}) {
+		t.Errorf("ApplyIndexed() = %v, want %v", l.ToSlice(), []int{5, 6, 7})
+	}
+
+	l.ApplyWhereIndexed(
+		func(i int, v int) bool { return i > 0 },
+		func(i int, v int) int { return v * i },
+	)
+	if !slices.Equal(l.ToSlice(), []int{5, 6, 14}) {
+		t.Errorf("ApplyWhereIndexed() = %v, want %v", l.ToSlice(), []int{5, 6, 14})
+	}
+}
+
+func TestList_RemoveWhere(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice []int
+		pred  func(int) bool
+		want  []int
+	}{
+		{name: "remove head", slice: []int{1, 2, 3}, pred: func(i int) bool { return i == 1 }, want: []int{2, 3}},
+		{name: "remove tail", slice: []int{1, 2, 3}, pred: func(i int) bool { return i == 3 }, want: []int{1, 2}},
+		{name: "remove all", slice: []int{1, 2, 3}, pred: func(i int) bool { return true }, want: []int{}},
+		{name: "remove none", slice: []int{1, 2, 3}, pred: func(i int) bool { return false }, want: []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewList(tt.slice)
+			l.RemoveWhere(tt.pred)
+			if !slices.Equal(l.ToSlice(), tt.want) {
+				t.Errorf("RemoveWhere() = %v, want %v", l.ToSlice(), tt.want)
+			}
+			var backward []int
+			for _, v := range l.Backward() {
+				backward = append(backward, v)
+			}
+			slices.Reverse(backward)
+			if !slices.Equal(backward, tt.want) {
+				t.Errorf("Backward() = %v after RemoveWhere(), want %v", backward, tt.want)
+			}
+			l.Add(9)
+			if got, _ := l.Last(); got != 9 {
+				t.Errorf("Last() = %v after Add(), want %v", got, 9)
+			}
+		})
+	}
+}
+
+func TestList_FindAll(t *testing.T) {
+	l := NewList([]int{1, 2, 3, 4, 5, 6})
+	indices, values := l.FindAll(func(i int) bool { return i > 3 })
+	if !slices.Equal(indices, []int{3, 4, 5}) {
+		t.Errorf("FindAll() indices = %v, want %v", indices, []int{3, 4, 5})
+	}
+	if got := values.Take(2).ToSlice(); !slices.Equal(got, []int{4, 5}) {
+		t.Errorf("FindAll().Take() = %v, want %v", got, []int{4, 5})
+	}
+}
+
+func TestNewListOf(t *testing.T) {
+	l := NewListOf("a", "b", "c")
+	if !slices.Equal(l.ToSlice(), []string{"a", "b", "c"}) {
+		t.Errorf("NewListOf() = %v, want %v", l.ToSlice(), []string{"a", "b", "c"})
+	}
+}
+
+func TestList_MergeSorted(t *testing.T) {
+	a := NewList([]int{5, 3, 1})
+	b := NewList([]int{6, 4, 2})
+	got := a.MergeSorted(b, func(x, y int) bool { return x > y })
+	if !slices.Equal(got.ToSlice(), []int{6, 5, 4, 3, 2, 1}) {
+		t.Errorf("MergeSorted() = %v, want %v", got.ToSlice(), []int{6, 5, 4, 3, 2, 1})
+	}
+}
+
+func TestList_Or(t *testing.T) {
+	l := NewList([]int{1, 2, 3})
+	empty := NewList[int]()
+	if l.HeadOr(0) != 1 || empty.HeadOr(0) != 0 {
+		t.Errorf("HeadOr() = %v, %v, want %v, %v", l.HeadOr(0), empty.HeadOr(0), 1, 0)
+	}
+	if l.LastOr(0) != 3 || empty.LastOr(0) != 0 {
+		t.Errorf("LastOr() = %v, %v, want %v, %v", l.LastOr(0), empty.LastOr(0), 3, 0)
+	}
+	if got := l.FindOr(func(i int) bool { return i > 1 }, 0); got != 2 {
+		t.Errorf("FindOr() = %v, want %v", got, 2)
+	}
+}
+
+func TestList_Tap(t *testing.T) {
+	var lengths []int
+	var inspected []int
+	got := NewList([]int{1, 2, 3, 4}).
+		Tap(func(c collection.Collection[int]) { lengths = append(lengths, c.Length()) }).
+		Filter(func(i int) bool { return i%2 == 0 }).
+		InspectEach(func(_ int, v int) { inspected = append(inspected, v) })
+	if !slices.Equal(got.ToSlice(), []int{2, 4}) {
+		t.Errorf("Tap() changed the chain result to %v, want %v", got.ToSlice(), []int{2, 4})
+	}
+	if !slices.Equal(lengths, []int{4}) || !slices.Equal(inspected, []int{2, 4}) {
+		t.Errorf("Tap() = %v, InspectEach() = %v, want %v, %v", lengths, inspected, []int{4}, []int{2, 4})
+	}
+}
+
+func TestList_Freeze(t *testing.T) {
+	l := NewList([]int{1, 2, 3})
+	v := l.Freeze()
+	if _, ok := v.(*List[int]); ok {
+		t.Errorf("Freeze() exposed the underlying list")
+	}
+	l.Add(4)
+	if v.Length() != 4 || v.At(3) != 4 {
+		t.Errorf("Freeze() view did not reflect changes to the list")
+	}
+}
+
+func TestList_StartsWithEndsWith(t *testing.T) {
+	eq := func(a, b []int) bool { return slices.Equal(a, b) }
+	l := NewList([][]int{{1}, {2, 3}, {4}})
+	if !l.StartsWith(NewList([][]int{{1}, {2, 3}}), eq) {
+		t.Errorf("StartsWith() = %v, want %v", false, true)
+	}
+	if l.StartsWith(NewList([][]int{{2, 3}}), eq) {
+		t.Errorf("StartsWith() = %v, want %v", true, false)
+	}
+	if !l.EndsWith(NewList([][]int{{2, 3}, {4}}), eq) {
+		t.Errorf("EndsWith() = %v, want %v", false, true)
+	}
+	if l.EndsWith(NewList([][]int{{1}}), eq) {
+		t.Errorf("EndsWith() = %v, want %v", true, false)
+	}
+}
+
+func TestList_AppendToSlice(t *testing.T) {
+	l := NewList([]int{2, 3})
+	got := l.AppendToSlice([]int{1})
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("AppendToSlice() = %v, want %v", got, []int{1, 2, 3})
+	}
+}
+
+func TestList_Drain(t *testing.T) {
+	l := NewList([]int{1, 2, 3})
+	var got []int
+	for v := range l.Drain() {
+		got = append(got, v)
+		if v == 1 {
+			l.Enqueue(4)
+		}
+	}
+	if !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("Drain() = %v, want %v", got, []int{1, 2, 3, 4})
+	}
+	if l.Length() != 0 || l.head != nil || l.tail != nil {
+		t.Errorf("Drain() did not empty the list, got %v", l.ToSlice())
+	}
+
+	l = NewList([]int{1, 2, 3})
+	for v := range l.Drain() {
+		if v == 2 {
+			break
+		}
+	}
+	if !slices.Equal(l.ToSlice(), []int{3}) {
+		t.Errorf("Drain() remaining = %v, want %v", l.ToSlice(), []int{3})
+	}
+}
+
+func TestList_PopDequeueUnlink(t *testing.T) {
+	l := NewList([]int{1, 2, 3, 4})
+	l.Pop()
+	l.Dequeue()
+	if got := l.ToSlice(); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("Values() after Pop() and Dequeue() = %v, want %v", got, []int{2, 3})
+	}
+	var backward []int
+	for _, v := range l.Backward() {
+		backward = append(backward, v)
+	}
+	if !slices.Equal(backward, []int{3, 2}) {
+		t.Errorf("Backward() after Pop() and Dequeue() = %v, want %v", backward, []int{3, 2})
+	}
+}
+
+func TestList_FillRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end int
+		want       []int
+	}{
+		{name: "middle", start: 1, end: 3, want: []int{1, 0, 0, 4}},
+		{name: "whole list", start: 0, end: 4, want: []int{0, 0, 0, 0}},
+		{name: "empty range", start: 4, end: 4, want: []int{1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewList([]int{1, 2, 3, 4}).FillRange(tt.start, tt.end, 0).ToSlice()
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("FillRange() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	defer func() {
+		if r := recover(); r != collection.IndexOutOfBoundsError {
+			t.Errorf("FillRange() panic = %v, want %v", r, collection.IndexOutOfBoundsError)
+		}
+	}()
+	NewList([]int{1, 2}).FillRange(-1, 1, 0)
+}
+
+func TestList_RemoveRetainRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end int
+		removed    []int
+		retained   []int
+	}{
+		{name: "middle", start: 1, end: 3, removed: []int{1, 4, 5}, retained: []int{2, 3}},
+		{name: "prefix", start: 0, end: 2, removed: []int{3, 4, 5}, retained: []int{1, 2}},
+		{name: "suffix", start: 3, end: 5, removed: []int{1, 2, 3}, retained: []int{4, 5}},
+		{name: "whole list", start: 0, end: 5, removed: []int{}, retained: []int{1, 2, 3, 4, 5}},
+		{name: "empty range", start: 2, end: 2, removed: []int{1, 2, 3, 4, 5}, retained: []int{}},
+	}
+	backward := func(l *List[int]) []int {
+		var values []int
+		for _, v := range l.Backward() {
+			values = append(values, v)
+		}
+		slices.Reverse(values)
+		return values
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewList([]int{1, 2, 3, 4, 5}).RemoveRange(tt.start, tt.end)
+			if got := l.ToSlice(); !slices.Equal(got, tt.removed) || l.Length() != len(tt.removed) {
+				t.Errorf("RemoveRange() = %v, want %v", got, tt.removed)
+			}
+			if got := backward(l); !slices.Equal(got, tt.removed) {
+				t.Errorf("RemoveRange() backward = %v, want %v", got, tt.removed)
+			}
+			l = NewList([]int{1, 2, 3, 4, 5}).RetainRange(tt.start, tt.end)
+			if got := l.ToSlice(); !slices.Equal(got, tt.retained) || l.Length() != len(tt.retained) {
+				t.Errorf("RetainRange() = %v, want %v", got, tt.retained)
+			}
+			if got := backward(l); !slices.Equal(got, tt.retained) {
+				t.Errorf("RetainRange() backward = %v, want %v", got, tt.retained)
+			}
+		})
+	}
+	defer func() {
+		if r := recover(); r != collection.IndexOutOfBoundsError {
+			t.Errorf("RetainRange() panic = %v, want %v", r, collection.IndexOutOfBoundsError)
+		}
+	}()
+	NewList([]int{1, 2}).RetainRange(0, 3)
+}
+
+func TestList_PageChunks(t *testing.T) {
+	l := NewList([]int{1, 2, 3, 4, 5})
+	page, info := l.Page(2, 2)
+	if !slices.Equal(page.ToSlice(), []int{5}) || info.HasNext {
+		t.Errorf("Page() = %v, %+v, want %v", page.ToSlice(), info, []int{5})
+	}
+	var chunks [][]int
+	for _, chunk := range l.Chunks(3) {
+		chunks = append(chunks, chunk.ToSlice())
+	}
+	if want := [][]int{{1, 2, 3}, {4, 5}}; !slices.EqualFunc(chunks, want, slices.Equal) {
+		t.Errorf("Chunks() = %v, want %v", chunks, want)
+	}
+}
+
+func TestList_ReplaceAllFunc(t *testing.T) {
+	l := NewList([]int{1, -2, 3, -4})
+	n := l.ReplaceAllFunc(func(i int) bool { return i < 0 }, 0)
+	if got := l.ToSlice(); n != 2 || !slices.Equal(got, []int{1, 0, 3, 0}) {
+		t.Errorf("ReplaceAllFunc() = %v %v, want %v %v", n, got, 2, []int{1, 0, 3, 0})
+	}
+}
+
+func TestList_SliceStep(t *testing.T) {
+	l := NewList([]int{0, 1, 2, 3, 4, 5})
+	if got := l.SliceStep(-4, -1, 2).ToSlice(); !slices.Equal(got, []int{2, 4}) {
+		t.Errorf("SliceStep() = %v, want %v", got, []int{2, 4})
+	}
+}
+
+func TestList_Pairwise(t *testing.T) {
+	var got []string
+	for prev, cur := range NewList([]string{"a", "b", "c"}).Pairwise() {
+		got = append(got, prev+cur)
+	}
+	if !slices.Equal(got, []string{"ab", "bc"}) {
+		t.Errorf("Pairwise() = %v, want %v", got, []string{"ab", "bc"})
+	}
+}
+
+func TestList_SliceSafe(t *testing.T) {
+	l := NewList([]int{1, 2, 3})
+	if got, err := l.SliceSafe(1, 3); err != nil || !slices.Equal(got.ToSlice(), []int{2, 3}) {
+		t.Errorf("SliceSafe() = %v, %v, want %v, %v", got, err, []int{2, 3}, nil)
+	}
+	if _, err := l.SliceSafe(-1, 2); err != collection.IndexOutOfBoundsError {
+		t.Errorf("SliceSafe() error = %v, want %v", err, collection.IndexOutOfBoundsError)
+	}
+}
+
+func TestList_SplitAtSafe(t *testing.T) {
+	l := NewList([]int{1, 2, 3})
+	left, right, err := l.SplitAtSafe(3)
+	if err != nil || !slices.Equal(left.ToSlice(), []int{1, 2, 3}) || right.Length() != 0 {
+		t.Errorf("SplitAtSafe() = %v, %v, %v, want %v, %v, %v", left, right, err, []int{1, 2, 3}, []int{}, nil)
+	}
+	if _, _, err := l.SplitAtSafe(4); err != collection.IndexOutOfBoundsError {
+		t.Errorf("SplitAtSafe() error = %v, want %v", err, collection.IndexOutOfBoundsError)
+	}
+}
+
+func TestList_ForEachWhile(t *testing.T) {
+	var visited []string
+	completed := NewList([]string{"a", "b"}).ForEachWhile(func(s string) bool {
+		visited = append(visited, s)
+		return true
+	})
+	if !completed || !slices.Equal(visited, []string{"a", "b"}) {
+		t.Errorf("ForEachWhile() = %v visiting %v, want %v visiting %v", completed, visited, true, []string{"a", "b"})
+	}
+}
+
+func TestList_Nodes(t *testing.T) {
+	l := NewList([]int{1})
+	two := l.AddNode(2)
+	three := l.AddNode(3)
+	if two.Value() != 2 || l.Length() != 3 {
+		t.Errorf("AddNode() = %v with length %v, want %v with length %v", two.Value(), l.Length(), 2, 3)
+	}
+	l.MoveToBack(two)
+	l.MoveToBack(two)
+	if !slices.Equal(l.ToSlice(), []int{1, 3, 2}) {
+		t.Errorf("MoveToBack() = %v, want %v", l.ToSlice(), []int{1, 3, 2})
+	}
+	if v := l.RemoveNode(three); v != 3 || !slices.Equal(l.ToSlice(), []int{1, 2}) {
+		t.Errorf("RemoveNode() = %v leaving %v, want %v leaving %v", v, l.ToSlice(), 3, []int{1, 2})
+	}
+	l.RemoveNode(two)
+	if last, _ := l.Last(); last != 1 || l.Length() != 1 {
+		t.Errorf("RemoveNode() of the tail left last = %v, want %v", last, 1)
+	}
+
+	// nodes that do not belong to the list are ignored.
+	other := NewList[int]()
+	four := other.AddNode(4)
+	l.MoveToBack(four)
+	l.RemoveNode(two)
+	l.RemoveNode(four)
+	if !slices.Equal(l.ToSlice(), []int{1}) || !slices.Equal(other.ToSlice(), []int{4}) {
+		t.Errorf("RemoveNode() of foreign nodes = %v and %v, want %v and %v", l.ToSlice(), other.ToSlice(), []int{1}, []int{4})
+	}
+	other.Clear()
+	if other.RemoveNode(four); other.Length() != 0 {
+		t.Errorf("RemoveNode() after Clear() left length %v, want %v", other.Length(), 0)
+	}
+}
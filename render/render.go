@@ -0,0 +1,214 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package render implements rendering of collections as tables.
+// Collections can be rendered as Markdown, CSV or aligned plain text,
+// which is useful for CLI tools and debug dumps.
+//
+// Columns are either derived from the exported fields of a struct
+// element type using reflection, or provided explicitly as a list
+// of field selector functions.
+package render
+
+import (
+	"encoding/csv"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charbz/gophers/collection"
+)
+
+// Column describes a table column by its header
+// and a function selecting the cell value of a row.
+type Column[T any] struct {
+	Header string
+	Value  func(T) string
+}
+
+// Fields returns a column for each exported field of the struct type T,
+// or of the struct pointed to if T is a pointer type. If T is not a struct
+// a single column named "Value" is returned.
+func Fields[T any]() []Column[T] {
+	t := reflect.TypeFor[T]()
+	isPtr := t.Kind() == reflect.Pointer
+	if isPtr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return []Column[T]{{Header: "Value", Value: func(v T) string { return fmt.Sprint(v) }}}
+	}
+	var columns []Column[T]
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+		index := field.Index
+		columns = append(columns, Column[T]{
+			Header: field.Name,
+			Value: func(v T) string {
+				rv := reflect.ValueOf(&v).Elem()
+				if isPtr {
+					if rv.IsNil() {
+						return ""
+					}
+					rv = rv.Elem()
+				}
+				f, err := rv.FieldByIndexErr(index)
+				if err != nil {
+					return ""
+				}
+				return fmt.Sprint(f.Interface())
+			},
+		})
+	}
+	return columns
+}
+
+// CSV renders the collection as comma separated values with a header row.
+// If no columns are provided they are derived using Fields.
+//
+// example usage:
+//
+//	people := sequence.NewSequence([]Person{{"Alice", 30}, {"Bob", 25}})
+//	CSV(people)
+//
+// output:
+//
+//	Name,Age
+//	Alice,30
+//	Bob,25
+func CSV[T any](c collection.Collection[T], columns ...Column[T]) string {
+	headers, rows := table(c, columns)
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	w.Write(headers)
+	w.WriteAll(rows)
+	return b.String()
+}
+
+// Markdown renders the collection as a Markdown table.
+// If no columns are provided they are derived using Fields.
+//
+// example usage:
+//
+//	people := sequence.NewSequence([]Person{{"Alice", 30}, {"Bob", 25}})
+//	Markdown(people)
+//
+// output:
+//
+//	| Name  | Age |
+//	| ----- | --- |
+//	| Alice | 30  |
+//	| Bob   | 25  |
+func Markdown[T any](c collection.Collection[T], columns ...Column[T]) string {
+	headers, rows := table(c, columns)
+	escape := strings.NewReplacer("|", `\|`, "\n", " ")
+	for i := range headers {
+		headers[i] = escape.Replace(headers[i])
+	}
+	for _, row := range rows {
+		for i := range row {
+			row[i] = escape.Replace(row[i])
+		}
+	}
+	widths := columnWidths(headers, rows, 3)
+	separator := make([]string, len(headers))
+	for i, w := range widths {
+		separator[i] = strings.Repeat("-", w)
+	}
+
+	var b strings.Builder
+	writeMarkdownRow(&b, headers, widths)
+	writeMarkdownRow(&b, separator, widths)
+	for _, row := range rows {
+		writeMarkdownRow(&b, row, widths)
+	}
+	return b.String()
+}
+
+// Text renders the collection as a plain text table with aligned columns.
+// If no columns are provided they are derived using Fields.
+//
+// example usage:
+//
+//	people := sequence.NewSequence([]Person{{"Alice", 30}, {"Bob", 25}})
+//	Text(people)
+//
+// output:
+//
+//	Name   Age
+//	-----  ---
+//	Alice  30
+//	Bob    25
+func Text[T any](c collection.Collection[T], columns ...Column[T]) string {
+	headers, rows := table(c, columns)
+	widths := columnWidths(headers, rows, 0)
+	separator := make([]string, len(headers))
+	for i, w := range widths {
+		separator[i] = strings.Repeat("-", w)
+	}
+
+	var b strings.Builder
+	writeTextRow(&b, headers, widths)
+	writeTextRow(&b, separator, widths)
+	for _, row := range rows {
+		writeTextRow(&b, row, widths)
+	}
+	return b.String()
+}
+
+// table computes the header and the cells of every row of the collection.
+func table[T any](c collection.Collection[T], columns []Column[T]) ([]string, [][]string) {
+	if len(columns) == 0 {
+		columns = Fields[T]()
+	}
+	headers := make([]string, len(columns))
+	for i, col := range columns {
+		headers[i] = col.Header
+	}
+	rows := make([][]string, 0, c.Length())
+	for v := range c.Values() {
+		row := make([]string, len(columns))
+		for i, col := range columns {
+			row[i] = col.Value(v)
+		}
+		rows = append(rows, row)
+	}
+	return headers, rows
+}
+
+func columnWidths(headers []string, rows [][]string, minWidth int) []int {
+	widths := make([]int, len(headers))
+	for i, h := range headers {
+		widths[i] = max(minWidth, utf8.RuneCountInString(h))
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+	return widths
+}
+
+func pad(s string, width int) string {
+	return s + strings.Repeat(" ", width-utf8.RuneCountInString(s))
+}
+
+func writeMarkdownRow(b *strings.Builder, cells []string, widths []int) {
+	b.WriteString("|")
+	for i, cell := range cells {
+		b.WriteString(" " + pad(cell, widths[i]) + " |")
+	}
+	b.WriteString("\n")
+}
+
+func writeTextRow(b *strings.Builder, cells []string, widths []int) {
+	line := make([]string, len(cells))
+	for i, cell := range cells {
+		line[i] = pad(cell, widths[i])
+	}
+	b.WriteString(strings.TrimRight(strings.Join(line, "  "), " ") + "\n")
+}
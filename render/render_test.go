@@ -0,0 +1,97 @@
+package render
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/charbz/gophers/sequence"
+)
+
+type person struct {
+	Name   string
+	Age    int
+	secret string
+}
+
+func TestFields(t *testing.T) {
+	columns := Fields[person]()
+	if len(columns) != 2 {
+		t.Fatalf("Fields() returned %v columns, want %v", len(columns), 2)
+	}
+	if columns[0].Header != "Name" || columns[1].Header != "Age" {
+		t.Errorf("Fields() headers = %v %v, want %v %v", columns[0].Header, columns[1].Header, "Name", "Age")
+	}
+	if got := columns[1].Value(person{Age: 42}); got != "42" {
+		t.Errorf("Fields() value = %v, want %v", got, "42")
+	}
+
+	ptrColumns := Fields[*person]()
+	if got := ptrColumns[0].Value(&person{Name: "Alice"}); got != "Alice" {
+		t.Errorf("Fields() value = %v, want %v", got, "Alice")
+	}
+	if got := ptrColumns[0].Value(nil); got != "" {
+		t.Errorf("Fields() value of nil = %q, want %q", got, "")
+	}
+
+	intColumns := Fields[int]()
+	if len(intColumns) != 1 || intColumns[0].Value(7) != "7" {
+		t.Errorf("Fields() on a non-struct type = %v, want a single Value column", intColumns)
+	}
+}
+
+func TestRender(t *testing.T) {
+	people := sequence.NewSequence([]person{{Name: "Alice", Age: 30}, {Name: "Bob", Age: 25}})
+
+	tests := []struct {
+		name   string
+		render func() string
+		want   string
+	}{
+		{
+			name:   "markdown",
+			render: func() string { return Markdown(people) },
+			want: "| Name  | Age |\n" +
+				"| ----- | --- |\n" +
+				"| Alice | 30  |\n" +
+				"| Bob   | 25  |\n",
+		},
+		{
+			name:   "text",
+			render: func() string { return Text(people) },
+			want: "Name   Age\n" +
+				"-----  ---\n" +
+				"Alice  30\n" +
+				"Bob    25\n",
+		},
+		{
+			name:   "csv",
+			render: func() string { return CSV(people) },
+			want:   "Name,Age\nAlice,30\nBob,25\n",
+		},
+		{
+			name: "markdown with selected columns",
+			render: func() string {
+				return Markdown(people,
+					Column[person]{Header: "Who|", Value: func(p person) string { return p.Name }},
+					Column[person]{Header: "Next", Value: func(p person) string { return strconv.Itoa(p.Age + 1) }},
+				)
+			},
+			want: "| Who\\| | Next |\n" +
+				"| ----- | ---- |\n" +
+				"| Alice | 31   |\n" +
+				"| Bob   | 26   |\n",
+		},
+		{
+			name:   "empty collection",
+			render: func() string { return Text(sequence.NewSequence[person]()) },
+			want:   "Name  Age\n----  ---\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.render(); got != tt.want {
+				t.Errorf("got:\n%v\nwant:\n%v", got, tt.want)
+			}
+		})
+	}
+}
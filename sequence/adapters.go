@@ -0,0 +1,123 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// adapters.go defines views and conversions allowing a Sequence to be used
+// with standard library packages such as sort, container/heap and iter, and
+// conversions between a Sequence and a ComparableSequence.
+
+package sequence
+
+import (
+	"cmp"
+	"container/heap"
+	"iter"
+	"sort"
+)
+
+// FromSlice returns a sequence backed by the given slice, without copying it.
+// Changes to the elements of the slice are visible in the sequence and vice versa.
+// Use NewSequence to create a sequence holding a copy of the slice.
+func FromSlice[T any](s []T) *Sequence[T] {
+	return &Sequence[T]{elements: s}
+}
+
+// FromSeq returns a new sequence holding the values yielded by the iterator, in order.
+//
+// example usage:
+//
+//	FromSeq(maps.Keys(m))
+//
+// possible output:
+//
+//	Seq(string) [b a c]
+func FromSeq[T any](seq iter.Seq[T]) *Sequence[T] {
+	c := &Sequence[T]{}
+	for v := range seq {
+		c.elements = append(c.elements, v)
+	}
+	return c
+}
+
+// AsComparableSequence returns a comparable sequence backed by the elements of the sequence,
+// without copying them, so that a sequence of ordered values can be passed to APIs requiring
+// a ComparableSequence. Changes to existing elements are visible in both sequences, while
+// elements added to or removed from one of them are not reflected in the other.
+// Use NewComparableSequence to create a comparable sequence holding a copy of the elements.
+//
+// example usage:
+//
+//	c := NewSequence([]int{3,1,2})
+//	AsComparableSequence(c).Max()
+//
+// output:
+//
+//	3
+func AsComparableSequence[T cmp.Ordered](c *Sequence[T]) *ComparableSequence[T] {
+	// limit the capacity, so that appending to one sequence never overwrites the other.
+	n := len(c.elements)
+	return &ComparableSequence[T]{Sequence[T]{elements: c.elements[:n:n]}}
+}
+
+// AsSequence returns the sequence underlying the comparable sequence, without copying it,
+// so that it can be passed to APIs requiring a Sequence. The returned sequence shares its
+// state with the comparable sequence: any change made through one is visible in the other.
+func (c *ComparableSequence[T]) AsSequence() *Sequence[T] {
+	return &c.Sequence
+}
+
+// SortView returns a sort.Interface view of the sequence ordered by the less function.
+// Sorting the view sorts the sequence in place.
+//
+// example usage:
+//
+//	c := NewSequence([]int{3,1,2})
+//	sort.Stable(c.SortView(func(a, b int) bool { return a < b }))
+//	c.ToSlice()
+//
+// output:
+//
+//	[1,2,3]
+func (c *Sequence[T]) SortView(less func(T, T) bool) sort.Interface {
+	return &sortView[T]{c: c, less: less}
+}
+
+// HeapView returns a heap.Interface view of the sequence ordered by the less function,
+// so that the sequence can be used as a priority queue with the container/heap package.
+//
+// example usage:
+//
+//	c := NewSequence([]int{3,1,2})
+//	h := c.HeapView(func(a, b int) bool { return a < b })
+//	heap.Init(h)
+//	heap.Push(h, 0)
+//	heap.Pop(h)
+//
+// output:
+//
+//	0
+func (c *Sequence[T]) HeapView(less func(T, T) bool) heap.Interface {
+	return &heapView[T]{sortView[T]{c: c, less: less}}
+}
+
+type sortView[T any] struct {
+	c    *Sequence[T]
+	less func(T, T) bool
+}
+
+func (v *sortView[T]) Len() int           { return len(v.c.elements) }
+func (v *sortView[T]) Less(i, j int) bool { return v.less(v.c.elements[i], v.c.elements[j]) }
+func (v *sortView[T]) Swap(i, j int) {
+	v.c.elements[i], v.c.elements[j] = v.c.elements[j], v.c.elements[i]
+}
+
+type heapView[T any] struct {
+	sortView[T]
+}
+
+func (v *heapView[T]) Push(x any) { v.c.elements = append(v.c.elements, x.(T)) }
+
+func (v *heapView[T]) Pop() any {
+	x, _ := v.c.Pop()
+	return x
+}
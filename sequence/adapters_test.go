@@ -0,0 +1,98 @@
+package sequence
+
+import (
+	"container/heap"
+	"maps"
+	"slices"
+	"sort"
+	"testing"
+)
+
+func TestFromSeq(t *testing.T) {
+	c := FromSeq(slices.Values([]int{3, 1, 2}))
+	if !slices.Equal(c.elements, []int{3, 1, 2}) {
+		t.Errorf("FromSeq() = %v, want %v", c.elements, []int{3, 1, 2})
+	}
+	keys := FromSeq(maps.Keys(map[string]int{"a": 1, "b": 2}))
+	if got := slices.Sorted(keys.Values()); !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("FromSeq() = %v, want %v", got, []string{"a", "b"})
+	}
+}
+
+func TestFromSlice(t *testing.T) {
+	s := []int{1, 2, 3}
+	c := FromSlice(s)
+	s[0] = 10
+	if c.At(0) != 10 {
+		t.Errorf("FromSlice() copied the slice, At(0) = %v, want %v", c.At(0), 10)
+	}
+}
+
+func TestSequence_SortView(t *testing.T) {
+	type pair struct {
+		key   int
+		value string
+	}
+	c := NewSequence([]pair{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}})
+	sort.Stable(c.SortView(func(a, b pair) bool { return a.key < b.key }))
+	var got []string
+	for _, p := range c.elements {
+		got = append(got, p.value)
+	}
+	if !slices.Equal(got, []string{"b", "d", "a", "c"}) {
+		t.Errorf("SortView() = %v, want %v", got, []string{"b", "d", "a", "c"})
+	}
+}
+
+func TestSequence_HeapView(t *testing.T) {
+	c := NewSequence([]int{5, 3, 8})
+	h := c.HeapView(func(a, b int) bool { return a < b })
+	heap.Init(h)
+	heap.Push(h, 1)
+	heap.Push(h, 4)
+	var got []int
+	for h.Len() > 0 {
+		got = append(got, heap.Pop(h).(int))
+	}
+	if !slices.Equal(got, []int{1, 3, 4, 5, 8}) {
+		t.Errorf("HeapView() popped %v, want %v", got, []int{1, 3, 4, 5, 8})
+	}
+	if c.Length() != 0 {
+		t.Errorf("HeapView() left %v elements in the sequence, want %v", c.Length(), 0)
+	}
+}
+
+func TestAsComparableSequence(t *testing.T) {
+	c := NewSequence([]int{3, 1, 2})
+	cs := AsComparableSequence(c)
+	if m := cs.Max(); m != 3 {
+		t.Errorf("Max() = %v, want %v", m, 3)
+	}
+	cs.ReplaceAll(1, 4)
+	if !slices.Equal(c.elements, []int{3, 4, 2}) {
+		t.Errorf("AsComparableSequence() = %v, want %v", c.elements, []int{3, 4, 2})
+	}
+}
+
+func TestAsComparableSequence_SpareCapacity(t *testing.T) {
+	c := NewSequence[int]()
+	c.Add(1)
+	c.Add(2)
+	c.Add(3)
+	cs := AsComparableSequence(c)
+	c.Add(100)
+	cs.Add(200)
+	if !slices.Equal(c.elements, []int{1, 2, 3, 100}) || !slices.Equal(cs.elements, []int{1, 2, 3, 200}) {
+		t.Errorf("Add() after AsComparableSequence() = %v and %v, want %v and %v",
+			c.elements, cs.elements, []int{1, 2, 3, 100}, []int{1, 2, 3, 200})
+	}
+}
+
+func TestComparableSequence_AsSequence(t *testing.T) {
+	cs := NewComparableSequence([]int{1, 2})
+	c := cs.AsSequence()
+	c.Add(3)
+	if !slices.Equal(cs.elements, []int{1, 2, 3}) {
+		t.Errorf("AsSequence() = %v, want %v", cs.elements, []int{1, 2, 3})
+	}
+}
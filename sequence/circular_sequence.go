@@ -0,0 +1,219 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package sequence
+
+import (
+	"fmt"
+	"iter"
+	"math/rand"
+
+	"github.com/charbz/gophers/collection"
+)
+
+// CircularSequence is a fixed capacity sequence backed by a ring buffer.
+// Once the sequence is full, adding an element overwrites the oldest one,
+// which makes it suitable for telemetry and sliding-window metrics.
+//
+// The buffer is allocated once by the constructor, adding elements never allocates.
+// Elements are indexed in logical order, from the oldest (index 0) to the newest.
+type CircularSequence[T any] struct {
+	buf   []T
+	start int
+	size  int
+}
+
+// NewCircularSequence is a constructor for a circular sequence with the given capacity.
+// If the initial elements exceed the capacity, only the most recent ones are kept.
+// It panics with an InvalidArgumentError if the capacity is not positive.
+//
+// example usage:
+//
+//	c := NewCircularSequence(3, []int{1,2})
+//	c.Push(3)
+//	c.Push(4)
+//	c.ToSlice()
+//
+// output:
+//
+//	[2 3 4]
+func NewCircularSequence[T any](capacity int, s ...[]T) *CircularSequence[T] {
+	if capacity <= 0 {
+		panic(collection.InvalidArgumentError)
+	}
+	c := &CircularSequence[T]{buf: make([]T, capacity)}
+	for _, slice := range s {
+		for _, v := range slice {
+			c.Push(v)
+		}
+	}
+	return c
+}
+
+// The following methods implement
+// the Collection interface.
+
+// Add appends an element to the sequence, overwriting the oldest element if it is full.
+func (c *CircularSequence[T]) Add(v T) {
+	c.Push(v)
+}
+
+// Length returns the number of elements in the sequence.
+func (c *CircularSequence[T]) Length() int {
+	return c.size
+}
+
+// New returns a new Sequence, rather than a circular sequence, so that collection
+// functions building their result from it never drop elements to the capacity.
+func (c *CircularSequence[T]) New(s ...[]T) collection.Collection[T] {
+	return NewSequence(s...)
+}
+
+// Random returns a random element from the sequence.
+//
+// Deprecated: Random returns the zero value when the sequence is empty. Use Choice instead,
+// which returns an EmptyCollectionError for every collection type.
+func (c *CircularSequence[T]) Random() T {
+	if c.size == 0 {
+		return *new(T)
+	}
+	return c.At(rand.Intn(c.size))
+}
+
+// Choice is an alias for collection.Choice
+func (c *CircularSequence[T]) Choice() (T, error) {
+	return collection.Choice(c)
+}
+
+// Values returns an iterator over all values of the sequence, from the oldest to the newest.
+func (c *CircularSequence[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range c.size {
+			if !yield(c.buf[c.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// The following methods implement
+// the OrderedCollection interface.
+
+// At returns the element at the given logical index, 0 being the oldest element.
+func (c *CircularSequence[T]) At(index int) T {
+	if index < 0 || index >= c.size {
+		panic(collection.IndexOutOfBoundsError)
+	}
+	return c.buf[c.index(index)]
+}
+
+// All returns an index/value iterator for all elements of the sequence.
+func (c *CircularSequence[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := range c.size {
+			if !yield(i, c.buf[c.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an index/value iterator for all elements of the sequence in reverse order.
+func (c *CircularSequence[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := c.size - 1; i >= 0; i-- {
+			if !yield(i, c.buf[c.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Slice returns a new circular sequence with the same capacity containing
+// the elements from the start index to the end index.
+func (c *CircularSequence[T]) Slice(start, end int) collection.OrderedCollection[T] {
+	if start < 0 || end > c.size || start > end {
+		panic(collection.IndexOutOfBoundsError)
+	}
+	s := NewCircularSequence[T](len(c.buf))
+	for i := start; i < end; i++ {
+		s.Push(c.buf[c.index(i)])
+	}
+	return s
+}
+
+// NewOrdered returns a new Sequence, see New.
+func (c *CircularSequence[T]) NewOrdered(s ...[]T) collection.OrderedCollection[T] {
+	return NewSequence(s...)
+}
+
+// The following methods are specific to the CircularSequence type.
+
+// Push appends an element to the sequence. If the sequence is full, the oldest
+// element is overwritten and returned along with true.
+func (c *CircularSequence[T]) Push(v T) (T, bool) {
+	if c.size < len(c.buf) {
+		c.buf[c.index(c.size)] = v
+		c.size++
+		return *new(T), false
+	}
+	evicted := c.buf[c.start]
+	c.buf[c.start] = v
+	c.start = (c.start + 1) % len(c.buf)
+	return evicted, true
+}
+
+// Oldest returns the oldest element of the sequence,
+// or an EmptyCollectionError if the sequence is empty.
+func (c *CircularSequence[T]) Oldest() (T, error) {
+	if c.size == 0 {
+		return *new(T), collection.EmptyCollectionError
+	}
+	return c.buf[c.start], nil
+}
+
+// Newest returns the most recently added element of the sequence,
+// or an EmptyCollectionError if the sequence is empty.
+func (c *CircularSequence[T]) Newest() (T, error) {
+	if c.size == 0 {
+		return *new(T), collection.EmptyCollectionError
+	}
+	return c.buf[c.index(c.size-1)], nil
+}
+
+// Capacity returns the maximum number of elements the sequence can hold.
+func (c *CircularSequence[T]) Capacity() int {
+	return len(c.buf)
+}
+
+// IsFull returns true if adding an element would overwrite the oldest one.
+func (c *CircularSequence[T]) IsFull() bool {
+	return c.size == len(c.buf)
+}
+
+// Clear removes all elements from the sequence, keeping its buffer.
+func (c *CircularSequence[T]) Clear() {
+	clear(c.buf)
+	c.start = 0
+	c.size = 0
+}
+
+// ToSlice returns a new slice containing the elements of the sequence in logical order.
+func (c *CircularSequence[T]) ToSlice() []T {
+	s := make([]T, 0, c.size)
+	for v := range c.Values() {
+		s = append(s, v)
+	}
+	return s
+}
+
+// Implement the Stringer interface.
+func (c *CircularSequence[T]) String() string {
+	return fmt.Sprintf("CircularSeq(%T) %v", *new(T), c.ToSlice())
+}
+
+// index returns the position in the buffer of the element at the given logical index.
+func (c *CircularSequence[T]) index(i int) int {
+	return (c.start + i) % len(c.buf)
+}
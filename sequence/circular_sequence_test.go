@@ -0,0 +1,121 @@
+package sequence
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/charbz/gophers/collection"
+)
+
+func TestCircularSequence_ImplementsOrderedCollection(t *testing.T) {
+	var _ collection.OrderedCollection[int] = NewCircularSequence[int](1)
+}
+
+func TestCircularSequence_Push(t *testing.T) {
+	c := NewCircularSequence(3, []int{1, 2})
+	steps := []struct {
+		push    int
+		evicted int
+		ok      bool
+		want    []int
+	}{
+		{push: 3, evicted: 0, ok: false, want: []int{1, 2, 3}},
+		{push: 4, evicted: 1, ok: true, want: []int{2, 3, 4}},
+		{push: 5, evicted: 2, ok: true, want: []int{3, 4, 5}},
+		{push: 6, evicted: 3, ok: true, want: []int{4, 5, 6}},
+		{push: 7, evicted: 4, ok: true, want: []int{5, 6, 7}},
+	}
+	for _, step := range steps {
+		evicted, ok := c.Push(step.push)
+		if evicted != step.evicted || ok != step.ok {
+			t.Errorf("Push(%v) = %v, %v, want %v, %v", step.push, evicted, ok, step.evicted, step.ok)
+		}
+		if got := c.ToSlice(); !slices.Equal(got, step.want) {
+			t.Errorf("Push(%v) = %v, want %v", step.push, got, step.want)
+		}
+	}
+
+	if v, err := c.Oldest(); v != 5 || err != nil {
+		t.Errorf("Oldest() = %v, %v, want %v, %v", v, err, 5, nil)
+	}
+	if v, err := c.Newest(); v != 7 || err != nil {
+		t.Errorf("Newest() = %v, %v, want %v, %v", v, err, 7, nil)
+	}
+	if !c.IsFull() || c.Capacity() != 3 || c.Length() != 3 {
+		t.Errorf("IsFull(), Capacity(), Length() = %v, %v, %v, want %v, %v, %v", c.IsFull(), c.Capacity(), c.Length(), true, 3, 3)
+	}
+}
+
+func TestCircularSequence_LogicalOrder(t *testing.T) {
+	c := NewCircularSequence(4, []int{1, 2, 3, 4, 5, 6})
+	if got := c.ToSlice(); !slices.Equal(got, []int{3, 4, 5, 6}) {
+		t.Errorf("NewCircularSequence() = %v, want %v", got, []int{3, 4, 5, 6})
+	}
+	for i, want := range []int{3, 4, 5, 6} {
+		if got := c.At(i); got != want {
+			t.Errorf("At(%v) = %v, want %v", i, got, want)
+		}
+	}
+	var backward []int
+	for _, v := range c.Backward() {
+		backward = append(backward, v)
+	}
+	if !slices.Equal(backward, []int{6, 5, 4, 3}) {
+		t.Errorf("Backward() = %v, want %v", backward, []int{6, 5, 4, 3})
+	}
+	if got := c.Slice(1, 3).(*CircularSequence[int]).ToSlice(); !slices.Equal(got, []int{4, 5}) {
+		t.Errorf("Slice() = %v, want %v", got, []int{4, 5})
+	}
+	if got := collection.Filter(c, func(i int) bool { return i%2 == 0 }); got.Length() != 2 {
+		t.Errorf("Filter() = %v, want %v", got, []int{4, 6})
+	}
+}
+
+func TestCircularSequence_Empty(t *testing.T) {
+	c := NewCircularSequence[int](2)
+	if _, err := c.Oldest(); err != collection.EmptyCollectionError {
+		t.Errorf("Oldest() error = %v, want %v", err, collection.EmptyCollectionError)
+	}
+	if _, err := c.Newest(); err != collection.EmptyCollectionError {
+		t.Errorf("Newest() error = %v, want %v", err, collection.EmptyCollectionError)
+	}
+	c.Push(1)
+	c.Push(2)
+	c.Push(3)
+	c.Clear()
+	if c.Length() != 0 || len(c.ToSlice()) != 0 {
+		t.Errorf("Clear() = %v, want %v", c.ToSlice(), []int{})
+	}
+
+	defer func() {
+		if r := recover(); r != collection.InvalidArgumentError {
+			t.Errorf("NewCircularSequence() panic = %v, want %v", r, collection.InvalidArgumentError)
+		}
+	}()
+	NewCircularSequence[int](0)
+}
+
+func TestCircularSequence_PushDoesNotAllocate(t *testing.T) {
+	c := NewCircularSequence[int](8)
+	allocs := testing.AllocsPerRun(100, func() {
+		c.Push(1)
+	})
+	if allocs != 0 {
+		t.Errorf("Push() allocated %v times, want %v", allocs, 0)
+	}
+}
+
+func TestCircularSequence_Merge(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	c := NewCircularSequence(3, []int{1, 4, 7})
+	s := NewSequence([]int{2, 3, 5})
+	if got := collection.MergeSorted(c, s, less).(*Sequence[int]).elements; !slices.Equal(got, []int{1, 2, 3, 4, 5, 7}) {
+		t.Errorf("MergeSorted() = %v, want %v", got, []int{1, 2, 3, 4, 5, 7})
+	}
+	if got := collection.MergeKSorted(less, c, s, s).(*Sequence[int]).elements; len(got) != 9 {
+		t.Errorf("MergeKSorted() = %v, want %v elements", got, 9)
+	}
+	if got := collection.MergeDistinctSorted(c, s, less).(*Sequence[int]).elements; !slices.Equal(got, []int{1, 2, 3, 4, 5, 7}) {
+		t.Errorf("MergeDistinctSorted() = %v, want %v", got, []int{1, 2, 3, 4, 5, 7})
+	}
+}
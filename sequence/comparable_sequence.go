@@ -8,6 +8,7 @@ import (
 	"cmp"
 	"iter"
 	"slices"
+	"sort"
 
 	"github.com/charbz/gophers/collection"
 )
@@ -37,10 +38,36 @@ func NewComparableSequence[T cmp.Ordered](s ...[]T) *ComparableSequence[T] {
 	return &ComparableSequence[T]{Sequence[T]{elements: slices.Concat(s...)}}
 }
 
+// NewComparableSequenceOf is a constructor for a sequence holding the given values.
+func NewComparableSequenceOf[T cmp.Ordered](v ...T) *ComparableSequence[T] {
+	return &ComparableSequence[T]{Sequence[T]{elements: slices.Clone(v)}}
+}
+
 // The following methods are mostly syntatic sugar
 // wrapping Collection functions to enable function chaining:
 // i.e. sequence.Filter(f).Take(n)
 
+// ApplyCount applies a function to each element in the sequence and returns the
+// number of elements whose value changed, as compared using ==.
+func (c *ComparableSequence[T]) ApplyCount(f func(T) T) int {
+	return c.ApplyChanged(func(v T) (T, bool) {
+		u := f(v)
+		return u, u != v
+	})
+}
+
+// ApplyWhereCount applies a function to each element in the sequence that satisfies
+// the predicate and returns the number of elements whose value changed.
+func (c *ComparableSequence[T]) ApplyWhereCount(p func(T) bool, f func(T) T) int {
+	return c.ApplyChanged(func(v T) (T, bool) {
+		if !p(v) {
+			return v, false
+		}
+		u := f(v)
+		return u, u != v
+	})
+}
+
 // Clone returns a copy of the collection. This is a shallow clone.
 func (c *ComparableSequence[T]) Clone() *ComparableSequence[T] {
 	return &ComparableSequence[T]{
@@ -72,6 +99,11 @@ func (c *ComparableSequence[T]) Corresponds(s *ComparableSequence[T], f func(T,
 	return collection.Corresponds(c, s, f)
 }
 
+// CountValue is an alias for collection.CountValue
+func (c *ComparableSequence[T]) CountValue(v T) int {
+	return collection.CountValue(c, v)
+}
+
 // Distinct returns a new sequence containing only the unique elements from the original sequence.
 func (c *ComparableSequence[T]) Distinct() *ComparableSequence[T] {
 	m := make(map[T]interface{})
@@ -86,6 +118,11 @@ func (c *ComparableSequence[T]) Distinct() *ComparableSequence[T] {
 	return r
 }
 
+// DistinctKeepLast is an alias for collection.DistinctKeepLast
+func (c *ComparableSequence[T]) DistinctKeepLast() *ComparableSequence[T] {
+	return collection.MustCast[*ComparableSequence[T]]("DistinctKeepLast", collection.DistinctKeepLast(c))
+}
+
 // Distincted is an alias for collection.Distincted
 func (c *ComparableSequence[T]) Distincted() iter.Seq[T] {
 	return collection.Distincted(c)
@@ -93,7 +130,7 @@ func (c *ComparableSequence[T]) Distincted() iter.Seq[T] {
 
 // Diff is an alias for collection.Diff
 func (c *ComparableSequence[T]) Diff(s *ComparableSequence[T]) *ComparableSequence[T] {
-	return collection.Diff(c, s).(*ComparableSequence[T])
+	return collection.MustCast[*ComparableSequence[T]]("Diff", collection.Diff(c, s))
 }
 
 // Diffed is an alias for collection.Diffed
@@ -111,6 +148,16 @@ func (c *ComparableSequence[T]) Exists(v T) bool {
 	return c.Contains(v)
 }
 
+// Fingerprint is an alias for collection.Fingerprint
+func (c *ComparableSequence[T]) Fingerprint() uint64 {
+	return collection.Fingerprint(c)
+}
+
+// Frequencies is an alias for collection.Frequencies
+func (c *ComparableSequence[T]) Frequencies() map[T]int {
+	return collection.Frequencies(c)
+}
+
 // IndexOf returns the index of the first occurrence of the specified element in this sequence,
 // or -1 if this sequence does not contain the element.
 func (c *ComparableSequence[T]) IndexOf(v T) int {
@@ -119,7 +166,7 @@ func (c *ComparableSequence[T]) IndexOf(v T) int {
 
 // Intersect returns a new sequence containing the elements that are present in both sequences.
 func (c *ComparableSequence[T]) Intersect(s *ComparableSequence[T]) *ComparableSequence[T] {
-	return collection.Intersect(c, s).(*ComparableSequence[T])
+	return collection.MustCast[*ComparableSequence[T]]("Intersect", collection.Intersect(c, s))
 }
 
 // IntersectIterator is an alias for collection.IntersectIterator
@@ -138,6 +185,42 @@ func (c *ComparableSequence[T]) LastIndexOf(v T) int {
 	return -1
 }
 
+// LowerBound returns the index of the first element that is not less than v
+// in a sequence sorted in ascending order, i.e. the first index at which v
+// could be inserted while keeping the sequence sorted. It runs in O(log n).
+// The result is undefined if the sequence is not sorted.
+//
+// example usage:
+//
+//	c := NewComparableSequence([]int{1,3,3,5})
+//	c.LowerBound(3)
+//
+// output:
+//
+//	1
+func (c *ComparableSequence[T]) LowerBound(v T) int {
+	i, _ := slices.BinarySearch(c.elements, v)
+	return i
+}
+
+// UpperBound returns the index of the first element that is greater than v
+// in a sequence sorted in ascending order, i.e. the last index at which v
+// could be inserted while keeping the sequence sorted. It runs in O(log n).
+// Together with LowerBound it can be used to slice a range of sorted values:
+// c.Slice(c.LowerBound(from), c.UpperBound(to)).
+//
+// example usage:
+//
+//	c := NewComparableSequence([]int{1,3,3,5})
+//	c.UpperBound(3)
+//
+// output:
+//
+//	3
+func (c *ComparableSequence[T]) UpperBound(v T) int {
+	return sort.Search(len(c.elements), func(i int) bool { return c.elements[i] > v })
+}
+
 // Max returns the maximum value in the sequence.
 func (c *ComparableSequence[T]) Max() T {
 	return slices.Max(c.elements)
@@ -148,6 +231,17 @@ func (c *ComparableSequence[T]) Min() T {
 	return slices.Min(c.elements)
 }
 
+// MergeDistinctSorted merges the sequence with another sequence, both sorted in
+// ascending order, into a new sorted sequence without duplicates in a single linear pass.
+func (c *ComparableSequence[T]) MergeDistinctSorted(s *ComparableSequence[T]) *ComparableSequence[T] {
+	return collection.MustCast[*ComparableSequence[T]]("MergeDistinctSorted", collection.MergeDistinctSorted(c, s, cmp.Less[T]))
+}
+
+// MostCommon is an alias for collection.MostCommon
+func (c *ComparableSequence[T]) MostCommon(n int) []collection.Frequency[T] {
+	return collection.MostCommon(c, n)
+}
+
 // Sum returns the sum of the elements in the sequence.
 func (c *ComparableSequence[T]) Sum() T {
 	var sum T
@@ -157,6 +251,24 @@ func (c *ComparableSequence[T]) Sum() T {
 	return sum
 }
 
+// Remove removes the first occurrence of a value from the sequence
+// and returns true if a value was removed. It implements the
+// MutableCollection interface, sequences of other types can use RemoveWhere instead.
+func (c *ComparableSequence[T]) Remove(v T) bool {
+	i := slices.Index(c.elements, v)
+	if i == -1 {
+		return false
+	}
+	c.elements = slices.Delete(c.elements, i, i+1)
+	return true
+}
+
+// ReplaceAll replaces every occurrence of old with new
+// and returns the number of elements replaced.
+func (c *ComparableSequence[T]) ReplaceAll(old, new T) int {
+	return c.ReplaceAllFunc(func(v T) bool { return v == old }, new)
+}
+
 // StartsWith returns true if the sequence starts with the given sequence.
 func (c *ComparableSequence[T]) StartsWith(other *ComparableSequence[T]) bool {
 	return collection.StartsWith(c, other)
@@ -166,3 +278,35 @@ func (c *ComparableSequence[T]) StartsWith(other *ComparableSequence[T]) bool {
 func (c *ComparableSequence[T]) EndsWith(other *ComparableSequence[T]) bool {
 	return collection.EndsWith(c, other)
 }
+
+// DedupCount removes duplicate elements from the sequence in place, keeping the first
+// occurrence of each value, and returns the number of elements removed, which is useful
+// to report data quality issues during ingestion.
+//
+// example usage:
+//
+//	c := NewComparableSequence([]string{"a","b","a","c","b"})
+//	c.DedupCount()
+//	c.ToSlice()
+//
+// output:
+//
+//	2
+//	[a b c]
+func (c *ComparableSequence[T]) DedupCount() int {
+	n := len(c.elements)
+	seen := make(map[T]struct{}, n)
+	c.elements = slices.DeleteFunc(c.elements, func(v T) bool {
+		if _, ok := seen[v]; ok {
+			return true
+		}
+		seen[v] = struct{}{}
+		return false
+	})
+	return n - len(c.elements)
+}
+
+// EqualsRotated is an alias for collection.EqualsRotated
+func (c *ComparableSequence[T]) EqualsRotated(other *ComparableSequence[T]) bool {
+	return collection.EqualsRotated(c, other)
+}
@@ -2,7 +2,10 @@ package sequence
 
 import (
 	"slices"
+	"strings"
 	"testing"
+
+	"github.com/charbz/gophers/collection"
 )
 
 func TestContains(t *testing.T) {
@@ -25,6 +28,16 @@ func TestExists(t *testing.T) {
 	}
 }
 
+func TestCountValue(t *testing.T) {
+	c := NewComparableSequence([]int{1, 2, 2, 3, 2})
+	if got := c.CountValue(2); got != 3 {
+		t.Errorf("CountValue() = %v, want %v", got, 3)
+	}
+	if got := c.CountValue(7); got != 0 {
+		t.Errorf("CountValue() = %v, want %v", got, 0)
+	}
+}
+
 func TestEquals(t *testing.T) {
 	c1 := NewComparableSequence([]int{1, 2, 3})
 	c2 := NewComparableSequence([]int{1, 2, 3})
@@ -227,3 +240,140 @@ func TestEndsWith(t *testing.T) {
 		})
 	}
 }
+
+func TestNewComparableSequenceOf(t *testing.T) {
+	c := NewComparableSequenceOf(3, 1, 2)
+	if !slices.Equal(c.elements, []int{3, 1, 2}) || c.Max() != 3 {
+		t.Errorf("NewComparableSequenceOf() = %v, want %v", c.elements, []int{3, 1, 2})
+	}
+}
+
+func TestReplaceAll(t *testing.T) {
+	c := NewComparableSequence([]string{"a", "b", "a", "c"})
+	n := c.ReplaceAll("a", "z")
+	if n != 2 || !slices.Equal(c.elements, []string{"z", "b", "z", "c"}) {
+		t.Errorf("ReplaceAll() = %v %v, want %v %v", n, c.elements, 2, []string{"z", "b", "z", "c"})
+	}
+	if n := c.ReplaceAll("x", "y"); n != 0 {
+		t.Errorf("ReplaceAll() = %v, want %v", n, 0)
+	}
+}
+
+func TestLowerUpperBound(t *testing.T) {
+	c := NewComparableSequence([]int{1, 3, 3, 5, 8})
+	tests := []struct {
+		v            int
+		lower, upper int
+	}{
+		{v: 0, lower: 0, upper: 0},
+		{v: 1, lower: 0, upper: 1},
+		{v: 3, lower: 1, upper: 3},
+		{v: 4, lower: 3, upper: 3},
+		{v: 8, lower: 4, upper: 5},
+		{v: 9, lower: 5, upper: 5},
+	}
+	for _, tt := range tests {
+		if got := c.LowerBound(tt.v); got != tt.lower {
+			t.Errorf("LowerBound(%v) = %v, want %v", tt.v, got, tt.lower)
+		}
+		if got := c.UpperBound(tt.v); got != tt.upper {
+			t.Errorf("UpperBound(%v) = %v, want %v", tt.v, got, tt.upper)
+		}
+	}
+	if got := c.Slice(c.LowerBound(2), c.UpperBound(5)).(*Sequence[int]).elements; !slices.Equal(got, []int{3, 3, 5}) {
+		t.Errorf("Slice(LowerBound(2), UpperBound(5)) = %v, want %v", got, []int{3, 3, 5})
+	}
+}
+
+func TestMergeDistinctSorted(t *testing.T) {
+	ids := NewComparableSequence([]int{1, 4, 9})
+	got := ids.MergeDistinctSorted(NewComparableSequence([]int{2, 4, 4, 10}))
+	if want := []int{1, 2, 4, 9, 10}; !slices.Equal(got.elements, want) {
+		t.Errorf("MergeDistinctSorted() = %v, want %v", got.elements, want)
+	}
+}
+
+func TestDistinctKeepLast(t *testing.T) {
+	c := NewComparableSequence([]int{1, 2, 1, 3, 2})
+	if got := c.DistinctKeepLast().elements; !slices.Equal(got, []int{1, 3, 2}) {
+		t.Errorf("DistinctKeepLast() = %v, want %v", got, []int{1, 3, 2})
+	}
+}
+
+func TestApplyCount(t *testing.T) {
+	c := NewComparableSequence([]string{"a", "B", "c"})
+	if n := c.ApplyCount(strings.ToUpper); n != 2 || !slices.Equal(c.elements, []string{"A", "B", "C"}) {
+		t.Errorf("ApplyCount() = %v %v, want %v %v", n, c.elements, 2, []string{"A", "B", "C"})
+	}
+	if n := c.ApplyCount(strings.ToUpper); n != 0 {
+		t.Errorf("ApplyCount() = %v on a no-op pass, want %v", n, 0)
+	}
+	n := c.ApplyWhereCount(func(s string) bool { return s != "B" }, strings.ToLower)
+	if n != 2 || !slices.Equal(c.elements, []string{"a", "B", "c"}) {
+		t.Errorf("ApplyWhereCount() = %v %v, want %v %v", n, c.elements, 2, []string{"a", "B", "c"})
+	}
+}
+
+func TestEqualsRotated(t *testing.T) {
+	square := NewComparableSequence([]string{"a", "b", "c", "d"})
+	if !square.EqualsRotated(NewComparableSequence([]string{"c", "d", "a", "b"})) {
+		t.Errorf("EqualsRotated() = %v, want %v", false, true)
+	}
+	if square.EqualsRotated(NewComparableSequence([]string{"a", "c", "b", "d"})) {
+		t.Errorf("EqualsRotated() = %v, want %v", true, false)
+	}
+}
+
+func TestComparableSequence_DedupCount(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []string
+		want    []string
+		removed int
+	}{
+		{name: "duplicates", input: []string{"a", "b", "a", "c", "b"}, want: []string{"a", "b", "c"}, removed: 2},
+		{name: "no duplicates", input: []string{"a", "b"}, want: []string{"a", "b"}, removed: 0},
+		{name: "empty", input: []string{}, want: []string{}, removed: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewComparableSequence(tt.input)
+			if n := c.DedupCount(); n != tt.removed || !slices.Equal(c.elements, tt.want) {
+				t.Errorf("DedupCount() = %v, %v, want %v, %v", n, c.elements, tt.removed, tt.want)
+			}
+		})
+	}
+}
+
+func TestComparableSequence_ImplementsMutableCollection(t *testing.T) {
+	var c collection.MutableCollection[int] = NewComparableSequence([]int{1, 2, 3, 2})
+	c.Remove(2)
+	if !slices.Equal(c.(*ComparableSequence[int]).elements, []int{1, 3, 2}) {
+		t.Errorf("Remove() = %v, want %v", c.(*ComparableSequence[int]).elements, []int{1, 3, 2})
+	}
+	c.AddAll(4, 5, 6)
+	c.RemoveWhere(func(i int) bool { return i%2 == 0 })
+	if !slices.Equal(c.(*ComparableSequence[int]).elements, []int{1, 3, 5}) {
+		t.Errorf("RemoveWhere() = %v, want %v", c.(*ComparableSequence[int]).elements, []int{1, 3, 5})
+	}
+	c.Clear()
+	if c.Length() != 0 {
+		t.Errorf("Clear() left %v elements, want %v", c.Length(), 0)
+	}
+}
+
+func TestComparableSequence_Remove(t *testing.T) {
+	c := NewComparableSequence([]int{1, 2, 3, 2})
+	if !c.Remove(2) {
+		t.Errorf("Remove() = %v, want %v", false, true)
+	}
+	if c.Remove(4) {
+		t.Errorf("Remove() of a missing value = %v, want %v", true, false)
+	}
+	if got := c.RemoveWhere(func(i int) bool { return i > 1 }); got != 2 {
+		t.Errorf("RemoveWhere() = %v, want %v", got, 2)
+	}
+	if !slices.Equal(c.elements, []int{1}) {
+		t.Errorf("Remove() = %v, want %v", c.elements, []int{1})
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package sequence
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+	"math/rand"
+	"sort"
+
+	"github.com/charbz/gophers/collection"
+)
+
+// FilterView is a read-only view over the elements of a sequence that satisfied a predicate.
+// Rather than copying the selected elements, it records their positions in a bitmap using one
+// bit per element of the sequence, plus a running count of the selected elements for every 64
+// elements, so that At runs in O(log n) time. This makes filtering very large sequences cheap
+// in memory, especially when elements are large or when many elements are selected.
+//
+// The selection is computed once when the view is created. Changes to the values of the
+// selected elements are visible through the view, but the view must not be used after the
+// length of the underlying sequence has changed.
+type FilterView[T any] struct {
+	seq   *Sequence[T]
+	bits  []uint64
+	ranks []int
+	size  int
+}
+
+// FilterView returns a read-only view over the elements of the sequence that satisfy
+// the predicate, without copying them. Use Materialize to obtain a new sequence.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,3,4,5,6})
+//	v := c.FilterView(func(i int) bool { return i%2 == 0 })
+//	v.At(1)
+//
+// output:
+//
+//	4
+func (c *Sequence[T]) FilterView(f func(T) bool) *FilterView[T] {
+	n := len(c.elements)
+	v := &FilterView[T]{
+		seq:   c,
+		bits:  make([]uint64, (n+63)/64),
+		ranks: make([]int, (n+63)/64),
+	}
+	for i, e := range c.elements {
+		if i%64 == 0 {
+			v.ranks[i/64] = v.size
+		}
+		if f(e) {
+			v.bits[i/64] |= 1 << (i % 64)
+			v.size++
+		}
+	}
+	return v
+}
+
+// Length returns the number of elements in the view.
+func (v *FilterView[T]) Length() int {
+	return v.size
+}
+
+// Random returns a random element from the view,
+// or the zero value if the view is empty.
+func (v *FilterView[T]) Random() T {
+	if v.size == 0 {
+		return *new(T)
+	}
+	return v.At(rand.Intn(v.size))
+}
+
+// At returns the element at the given index of the view.
+// It panics with an IndexOutOfBoundsError if the index is out of range.
+func (v *FilterView[T]) At(index int) T {
+	if index < 0 || index >= v.size {
+		panic(collection.IndexOutOfBoundsError)
+	}
+	return v.seq.elements[v.position(index)]
+}
+
+// position returns the index in the sequence of the element at the given index of the view.
+func (v *FilterView[T]) position(index int) int {
+	// find the last word whose running count does not exceed the index,
+	// then the matching set bit within that word.
+	w := sort.Search(len(v.ranks), func(i int) bool { return v.ranks[i] > index }) - 1
+	word := v.bits[w]
+	for range index - v.ranks[w] {
+		word &= word - 1
+	}
+	return w*64 + bits.TrailingZeros64(word)
+}
+
+// All returns an iterator over index/value pairs of the view.
+func (v *FilterView[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for w, word := range v.bits {
+			for ; word != 0; word &= word - 1 {
+				if !yield(i, v.seq.elements[w*64+bits.TrailingZeros64(word)]) {
+					return
+				}
+				i++
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over index/value pairs of the view in reverse order.
+func (v *FilterView[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := v.size - 1
+		for w := len(v.bits) - 1; w >= 0; w-- {
+			for word := v.bits[w]; word != 0; i-- {
+				b := 63 - bits.LeadingZeros64(word)
+				word &^= 1 << b
+				if !yield(i, v.seq.elements[w*64+b]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the view.
+func (v *FilterView[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, e := range v.All() {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Materialize returns a new sequence holding a copy of the elements of the view.
+func (v *FilterView[T]) Materialize() *Sequence[T] {
+	elements := make([]T, 0, v.size)
+	for e := range v.Values() {
+		elements = append(elements, e)
+	}
+	return &Sequence[T]{elements: elements}
+}
+
+// implement the Stringer interface
+func (v *FilterView[T]) String() string {
+	return fmt.Sprintf("FilterView(%T) %v", *new(T), v.Materialize().elements)
+}
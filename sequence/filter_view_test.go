@@ -0,0 +1,77 @@
+package sequence
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/charbz/gophers/collection"
+)
+
+func TestFilterView_ImplementsReadOnlyOrderedCollection(t *testing.T) {
+	var _ collection.ReadOnlyOrderedCollection[int] = NewSequence[int]().FilterView(func(int) bool { return true })
+}
+
+func TestSequence_FilterView(t *testing.T) {
+	tests := []struct {
+		name string
+		size int
+		pred func(int) bool
+	}{
+		{name: "even", size: 200, pred: func(i int) bool { return i%2 == 0 }},
+		{name: "sparse", size: 500, pred: func(i int) bool { return i%97 == 3 }},
+		{name: "word boundaries", size: 192, pred: func(i int) bool { return i%64 == 0 || i%64 == 63 }},
+		{name: "none", size: 100, pred: func(int) bool { return false }},
+		{name: "all", size: 130, pred: func(int) bool { return true }},
+		{name: "empty", size: 0, pred: func(int) bool { return true }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Gen(tt.size, func(i int) int { return i })
+			want := slices.DeleteFunc(slices.Clone(c.elements), func(i int) bool { return !tt.pred(i) })
+			v := c.FilterView(tt.pred)
+			if v.Length() != len(want) {
+				t.Fatalf("Length() = %v, want %v", v.Length(), len(want))
+			}
+			if got := v.Materialize().elements; !slices.Equal(got, want) {
+				t.Errorf("Materialize() = %v, want %v", got, want)
+			}
+			for i, w := range want {
+				if got := v.At(i); got != w {
+					t.Errorf("At(%v) = %v, want %v", i, got, w)
+				}
+			}
+			var backward []int
+			for i, e := range v.Backward() {
+				if e != want[i] {
+					t.Errorf("Backward() yielded %v at %v, want %v", e, i, want[i])
+				}
+				backward = append(backward, e)
+			}
+			slices.Reverse(backward)
+			if !slices.Equal(backward, want) {
+				t.Errorf("Backward() = %v, want %v", backward, want)
+			}
+		})
+	}
+}
+
+func TestFilterView_SharesElements(t *testing.T) {
+	c := NewSequence([]int{1, 2, 3, 4})
+	v := c.FilterView(func(i int) bool { return i > 2 })
+	c.elements[2] = 30
+	if got := v.At(0); got != 30 {
+		t.Errorf("At(0) = %v, want %v", got, 30)
+	}
+	m := v.Materialize()
+	m.elements[0] = 300
+	if c.elements[2] != 30 {
+		t.Errorf("Materialize() shares elements with the sequence")
+	}
+
+	defer func() {
+		if r := recover(); r != collection.IndexOutOfBoundsError {
+			t.Errorf("At() panic = %v, want %v", r, collection.IndexOutOfBoundsError)
+		}
+	}()
+	v.At(2)
+}
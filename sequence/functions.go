@@ -0,0 +1,99 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// functions.go defines package functions that operate on a Sequence
+// and return a Sequence of a different type, or generate a new Sequence.
+// Go does not allow methods to define new type parameters, so these
+// cannot be methods of Sequence.
+
+package sequence
+
+import "github.com/charbz/gophers/collection"
+
+// ReverseMap applies a mapping function to each element of the sequence
+// in reverse order and returns a new sequence of the results.
+//
+// example usage:
+//
+//	names := NewSequence([]string{"Alice", "Bob", "Charlie"})
+//	ReverseMap(names, func(name string) int {
+//	  return len(name)
+//	})
+//
+// output:
+//
+//	Seq(int) [7 3 5]
+func ReverseMap[T, K any](s *Sequence[T], f func(T) K) *Sequence[K] {
+	return &Sequence[K]{elements: collection.ReverseMap(s, f)}
+}
+
+// MapTo applies a mapping function to each element of the collection
+// and returns a new sequence of the results, allocated once to the final size.
+//
+// example usage:
+//
+//	names := NewSequence([]string{"Alice", "Bob", "Charlie"})
+//	MapTo(names, func(name string) int {
+//	  return len(name)
+//	})
+//
+// output:
+//
+//	Seq(int) [5 3 7]
+func MapTo[T, K any](s collection.Collection[T], f func(T) K) *Sequence[K] {
+	elements := make([]K, 0, s.Length())
+	for v := range s.Values() {
+		elements = append(elements, f(v))
+	}
+	return &Sequence[K]{elements: elements}
+}
+
+// Gen returns a new sequence of n elements where the i-th element is f(i).
+// It panics with an InvalidArgumentError if n is negative.
+//
+// example usage:
+//
+//	Gen(4, func(i int) string { return strconv.Itoa(i) })
+//
+// output:
+//
+//	Seq(string) [0 1 2 3]
+func Gen[T any](n int, f func(int) T) *Sequence[T] {
+	if n < 0 {
+		panic(collection.InvalidArgumentError)
+	}
+	elements := make([]T, n)
+	for i := range elements {
+		elements[i] = f(i)
+	}
+	return &Sequence[T]{elements: elements}
+}
+
+// GenParallel is like Gen but calls f from the given number of goroutines,
+// see collection.GenerateParallel. f must be safe for concurrent use.
+func GenParallel[T any](n, workers int, f func(int) T) *Sequence[T] {
+	return &Sequence[T]{elements: collection.GenerateParallel(n, workers, f)}
+}
+
+// WithIndex returns a new sequence pairing every element of the collection with its position
+// in iteration order, so that positions survive chained transformations. Use
+// collection.KeepIndex to map the values while keeping their positions.
+//
+// example usage:
+//
+//	c := NewSequence([]int{5,6,7,8})
+//	WithIndex(c).Filter(func(e collection.Indexed[int]) bool { return e.Value % 2 == 0 })
+//
+// output:
+//
+//	Seq(collection.Indexed[int]) [{1 6} {3 8}]
+func WithIndex[T any](s collection.Collection[T]) *Sequence[collection.Indexed[T]] {
+	elements := make([]collection.Indexed[T], 0, s.Length())
+	i := 0
+	for v := range s.Values() {
+		elements = append(elements, collection.Indexed[T]{Index: i, Value: v})
+		i++
+	}
+	return &Sequence[collection.Indexed[T]]{elements: elements}
+}
@@ -0,0 +1,76 @@
+package sequence
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/charbz/gophers/collection"
+)
+
+func TestReverseMap(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+		want  []int
+	}{
+		{
+			name:  "map lengths in reverse",
+			input: []string{"Alice", "Bob", "Charlie"},
+			want:  []int{7, 3, 5},
+		},
+		{
+			name:  "empty sequence",
+			input: []string{},
+			want:  []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ReverseMap(NewSequence(tt.input), func(s string) int { return len(s) })
+			if !slices.Equal(got.elements, tt.want) {
+				t.Errorf("ReverseMap() = %v, want %v", got.elements, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapTo(t *testing.T) {
+	got := MapTo(NewSequence([]string{"Alice", "Bob", "Charlie"}), func(s string) int { return len(s) })
+	if !slices.Equal(got.elements, []int{5, 3, 7}) {
+		t.Errorf("MapTo() = %v, want %v", got.elements, []int{5, 3, 7})
+	}
+	if cap(got.elements) != 3 {
+		t.Errorf("MapTo() cap = %v, want %v", cap(got.elements), 3)
+	}
+	if got := MapTo(NewSequence[string](), func(s string) int { return len(s) }); got.Length() != 0 {
+		t.Errorf("MapTo() = %v, want empty", got)
+	}
+}
+
+func TestGen(t *testing.T) {
+	square := func(i int) int { return i * i }
+	want := []int{0, 1, 4, 9}
+	if got := Gen(4, square); !slices.Equal(got.elements, want) {
+		t.Errorf("Gen() = %v, want %v", got.elements, want)
+	}
+	if got := GenParallel(4, 2, square); !slices.Equal(got.elements, want) {
+		t.Errorf("GenParallel() = %v, want %v", got.elements, want)
+	}
+	if got := Gen(0, square); got.Length() != 0 {
+		t.Errorf("Gen() = %v, want empty", got)
+	}
+}
+
+func TestWithIndex(t *testing.T) {
+	words := WithIndex(NewSequence([]string{"go", "", "zig", ""}))
+	got := MapTo(words.Filter(func(w collection.Indexed[string]) bool { return w.Value != "" }), collection.KeepIndex(strings.ToUpper))
+	want := []collection.Indexed[string]{{Index: 0, Value: "GO"}, {Index: 2, Value: "ZIG"}}
+	if !slices.Equal(got.elements, want) {
+		t.Errorf("WithIndex() = %v, want %v", got.elements, want)
+	}
+	if got := WithIndex(NewSequence[int]()); got.Length() != 0 {
+		t.Errorf("WithIndex() = %v, want empty", got)
+	}
+}
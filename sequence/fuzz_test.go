@@ -0,0 +1,86 @@
+package sequence
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/charbz/gophers/gopherstest"
+)
+
+// fuzzValue maps fuzz input bytes to a small range of values so that duplicates are common.
+func fuzzValue(b byte) int { return int(b % 8) }
+
+func FuzzSequence_Mutations(f *testing.F) {
+	f.Add([]byte{0, 1, 1, 2, 0, 1, 2, 1, 3, 2, 4, 0, 0, 3})
+	f.Add([]byte{1, 255, 1, 7, 2, 7, 3, 127})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		gopherstest.AssertSameMutations(t, data, NewComparableSequence[int](), gopherstest.NewSliceModel[int](nil), fuzzValue, true)
+	})
+}
+
+// FuzzSequence_Operations compares the operations of a sequence with
+// reference implementations over a plain slice.
+func FuzzSequence_Operations(f *testing.F) {
+	f.Add([]byte{3, 1, 4, 1, 5, 9, 2, 6}, 3)
+	f.Add([]byte{}, 0)
+	f.Add([]byte{7, 7, 7}, -2)
+	f.Fuzz(func(t *testing.T, data []byte, n int) {
+		s := make([]int, len(data))
+		for i, b := range data {
+			s[i] = fuzzValue(b)
+		}
+		c := NewSequence(s)
+		k := min(max(n, 0), len(s))
+		even := func(i int) bool { return i%2 == 0 }
+
+		check := func(op string, got, want []int) {
+			t.Helper()
+			if !slices.Equal(got, want) {
+				t.Errorf("%s on %v = %v, want %v", op, s, got, want)
+			}
+		}
+		check("Take", c.Take(n).elements, s[:k])
+		check("TakeRight", c.TakeRight(n).elements, s[len(s)-k:])
+		check("Drop", c.Drop(n).elements, s[k:])
+		check("DropRight", c.DropRight(n).elements, s[:len(s)-k])
+		check("Filter", c.Filter(even).elements, slices.DeleteFunc(slices.Clone(s), func(i int) bool { return !even(i) }))
+		check("Reverse", c.Reverse().elements, reversed(s))
+		check("Distinct", c.Distinct(func(a, b int) bool { return a == b }).elements, distinct(s))
+		check("ComparableSequence.Distinct", NewComparableSequence(s).Distinct().elements, distinct(s))
+		// the SplitAt method keeps the element at index n in the left sequence, clamping n
+		// to [-1, len-1], while SplitAtSafe puts n elements in the left sequence as
+		// collection.SplitAtSafe does, rejecting n out of [0, len].
+		i := min(max(n, -1), len(s)-1) + 1
+		left, right := c.SplitAt(n)
+		check("SplitAt left", left.elements, s[:i])
+		check("SplitAt right", right.elements, s[i:])
+		if lo, hi, err := c.SplitAtSafe(n); err != nil {
+			if n >= 0 && n <= len(s) {
+				t.Errorf("SplitAtSafe(%v) on %v error = %v", n, s, err)
+			}
+		} else {
+			check("SplitAtSafe left", lo.elements, s[:n])
+			check("SplitAtSafe right", hi.elements, s[n:])
+		}
+		if !slices.Equal(c.elements, s) {
+			t.Errorf("operations modified the sequence: %v, want %v", c.elements, s)
+		}
+	})
+}
+
+func reversed(s []int) []int {
+	r := slices.Clone(s)
+	slices.Reverse(r)
+	return r
+}
+
+// distinct returns the first occurrence of each value of the slice.
+func distinct(s []int) []int {
+	var r []int
+	for _, v := range s {
+		if !slices.Contains(r, v) {
+			r = append(r, v)
+		}
+	}
+	return r
+}
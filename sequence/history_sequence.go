@@ -0,0 +1,231 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package sequence
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/charbz/gophers/collection"
+)
+
+// HistorySequence is a sequence that records its mutations, allowing them
+// to be undone and redone, for example to implement editor-like applications.
+//
+// Every mutation made through the methods of a HistorySequence stores a snapshot
+// of the previous state, which costs O(n) time and memory per mutation.
+// A new mutation discards any undone state that could have been redone.
+type HistorySequence[T any] struct {
+	seq         *Sequence[T]
+	undo        [][]T
+	redo        [][]T
+	checkpoints [][]T
+}
+
+// NewHistorySequence is a constructor for a history-tracked sequence.
+// The initial elements are not part of the history and cannot be undone.
+//
+// example usage:
+//
+//	h := NewHistorySequence([]string{"a"})
+//	h.Add("b")
+//	h.Add("c")
+//	h.Undo()
+//	h.ToSlice()
+//
+// output:
+//
+//	[a b]
+func NewHistorySequence[T any](s ...[]T) *HistorySequence[T] {
+	return &HistorySequence[T]{seq: NewSequence(s...)}
+}
+
+// The following methods implement
+// the Collection interface.
+
+// Add appends an element to the sequence.
+func (h *HistorySequence[T]) Add(v T) {
+	h.record(func() bool {
+		h.seq.Add(v)
+		return true
+	})
+}
+
+// Length returns the number of elements in the sequence.
+func (h *HistorySequence[T]) Length() int {
+	return h.seq.Length()
+}
+
+// New returns a new history-tracked sequence with an empty history.
+func (h *HistorySequence[T]) New(s ...[]T) collection.Collection[T] {
+	return NewHistorySequence(s...)
+}
+
+// Random returns a random element from the sequence.
+//
+// Deprecated: Random returns the zero value when the sequence is empty. Use Choice instead,
+// which returns an EmptyCollectionError for every collection type.
+func (h *HistorySequence[T]) Random() T {
+	return h.seq.Random()
+}
+
+// Choice is an alias for collection.Choice
+func (h *HistorySequence[T]) Choice() (T, error) {
+	return collection.Choice(h)
+}
+
+// Values returns an iterator over all values of the sequence.
+func (h *HistorySequence[T]) Values() iter.Seq[T] {
+	return h.seq.Values()
+}
+
+// The following methods implement
+// the OrderedCollection interface.
+
+// At returns the element at the given index.
+func (h *HistorySequence[T]) At(index int) T {
+	return h.seq.At(index)
+}
+
+// All returns an index/value iterator for all elements of the sequence.
+func (h *HistorySequence[T]) All() iter.Seq2[int, T] {
+	return h.seq.All()
+}
+
+// Backward returns an index/value iterator for all elements of the sequence in reverse order.
+func (h *HistorySequence[T]) Backward() iter.Seq2[int, T] {
+	return h.seq.Backward()
+}
+
+// Slice returns a new history-tracked sequence containing the elements
+// from the start index to the end index, with an empty history.
+func (h *HistorySequence[T]) Slice(start, end int) collection.OrderedCollection[T] {
+	return NewHistorySequence(h.seq.elements[start:end])
+}
+
+// NewOrdered returns a new history-tracked sequence with an empty history.
+func (h *HistorySequence[T]) NewOrdered(s ...[]T) collection.OrderedCollection[T] {
+	return NewHistorySequence(s...)
+}
+
+// The following methods implement
+// the MutableCollection interface.
+
+// AddAll appends all the given elements to the sequence as a single mutation.
+func (h *HistorySequence[T]) AddAll(v ...T) {
+	h.record(func() bool {
+		h.seq.AddAll(v...)
+		return true
+	})
+}
+
+// Clear removes all elements from the sequence.
+func (h *HistorySequence[T]) Clear() {
+	h.record(func() bool {
+		h.seq.Clear()
+		return true
+	})
+}
+
+// RemoveWhere removes all elements that satisfy the predicate from the sequence
+// and returns the number of elements removed.
+func (h *HistorySequence[T]) RemoveWhere(f func(T) bool) int {
+	n := 0
+	h.record(func() bool {
+		n = h.seq.RemoveWhere(f)
+		return n > 0
+	})
+	return n
+}
+
+// The following methods are specific to the HistorySequence type.
+
+// Apply applies a function to each element in the sequence.
+func (h *HistorySequence[T]) Apply(f func(T) T) *HistorySequence[T] {
+	h.record(func() bool {
+		h.seq.Apply(f)
+		return true
+	})
+	return h
+}
+
+// Pop removes the last element of the sequence and returns it.
+func (h *HistorySequence[T]) Pop() (T, error) {
+	var v T
+	var err error
+	h.record(func() bool {
+		v, err = h.seq.Pop()
+		return err == nil
+	})
+	return v, err
+}
+
+// Undo reverts the last mutation and returns true,
+// or returns false if there is nothing to undo.
+func (h *HistorySequence[T]) Undo() bool {
+	if len(h.undo) == 0 {
+		return false
+	}
+	h.redo = append(h.redo, h.seq.elements)
+	h.seq.elements = h.undo[len(h.undo)-1]
+	h.undo = h.undo[:len(h.undo)-1]
+	return true
+}
+
+// Redo reapplies the last undone mutation and returns true,
+// or returns false if there is nothing to redo.
+func (h *HistorySequence[T]) Redo() bool {
+	if len(h.redo) == 0 {
+		return false
+	}
+	h.undo = append(h.undo, h.seq.elements)
+	h.seq.elements = h.redo[len(h.redo)-1]
+	h.redo = h.redo[:len(h.redo)-1]
+	return true
+}
+
+// Checkpoint saves the current state of the sequence and returns
+// an identifier that can be passed to RollbackTo.
+func (h *HistorySequence[T]) Checkpoint() int {
+	h.checkpoints = append(h.checkpoints, slices.Clone(h.seq.elements))
+	return len(h.checkpoints) - 1
+}
+
+// RollbackTo restores the state saved by the given checkpoint.
+// The rollback is recorded as a mutation and can itself be undone.
+// It returns an InvalidArgumentError if the checkpoint does not exist.
+func (h *HistorySequence[T]) RollbackTo(checkpoint int) error {
+	if checkpoint < 0 || checkpoint >= len(h.checkpoints) {
+		return collection.InvalidArgumentError
+	}
+	h.record(func() bool {
+		h.seq.elements = slices.Clone(h.checkpoints[checkpoint])
+		return true
+	})
+	return nil
+}
+
+// ToSlice returns a copy of the elements of the sequence.
+func (h *HistorySequence[T]) ToSlice() []T {
+	return h.seq.ToSliceCopy()
+}
+
+// Implement the Stringer interface.
+func (h *HistorySequence[T]) String() string {
+	return fmt.Sprintf("HistorySeq(%T) %v", *new(T), h.seq.elements)
+}
+
+// record runs a mutation and, if it reports a change, stores the previous
+// state on the undo stack and discards the redo stack.
+func (h *HistorySequence[T]) record(mutate func() bool) bool {
+	snapshot := slices.Clone(h.seq.elements)
+	if !mutate() {
+		return false
+	}
+	h.undo = append(h.undo, snapshot)
+	h.redo = nil
+	return true
+}
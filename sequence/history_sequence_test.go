@@ -0,0 +1,113 @@
+package sequence
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/charbz/gophers/collection"
+)
+
+func TestHistorySequence_ImplementsInterfaces(t *testing.T) {
+	var _ collection.OrderedCollection[int] = NewHistorySequence[int]()
+	var _ collection.RemovableCollection[int] = NewHistorySequence[int]()
+	var _ collection.MutableCollection[int] = collection.WithRemove[int](NewHistorySequence[int]())
+}
+
+func TestHistorySequence_UndoRedo(t *testing.T) {
+	h := NewHistorySequence([]string{"a"})
+	h.Add("b")
+	h.AddAll("c", "d")
+	h.Apply(func(s string) string { return s + "!" })
+
+	steps := []struct {
+		name string
+		op   func() bool
+		ok   bool
+		want []string
+	}{
+		{name: "undo apply", op: h.Undo, ok: true, want: []string{"a", "b", "c", "d"}},
+		{name: "undo add all", op: h.Undo, ok: true, want: []string{"a", "b"}},
+		{name: "redo add all", op: h.Redo, ok: true, want: []string{"a", "b", "c", "d"}},
+		{name: "undo add all again", op: h.Undo, ok: true, want: []string{"a", "b"}},
+		{name: "undo add", op: h.Undo, ok: true, want: []string{"a"}},
+		{name: "nothing to undo", op: h.Undo, ok: false, want: []string{"a"}},
+		{name: "redo add", op: h.Redo, ok: true, want: []string{"a", "b"}},
+	}
+	for _, step := range steps {
+		if ok := step.op(); ok != step.ok {
+			t.Errorf("%s: got %v, want %v", step.name, ok, step.ok)
+		}
+		if got := h.ToSlice(); !slices.Equal(got, step.want) {
+			t.Errorf("%s: ToSlice() = %v, want %v", step.name, got, step.want)
+		}
+	}
+
+	h.Add("x")
+	if h.Redo() {
+		t.Errorf("Redo() = %v after a new mutation, want %v", true, false)
+	}
+	if got := h.ToSlice(); !slices.Equal(got, []string{"a", "b", "x"}) {
+		t.Errorf("ToSlice() = %v, want %v", got, []string{"a", "b", "x"})
+	}
+}
+
+func TestHistorySequence_NoOpMutations(t *testing.T) {
+	h := NewHistorySequence([]int{1, 2, 3})
+	if collection.WithRemove[int](h).Remove(7) {
+		t.Errorf("Remove() = %v, want %v", true, false)
+	}
+	if n := h.RemoveWhere(func(i int) bool { return i > 5 }); n != 0 {
+		t.Errorf("RemoveWhere() = %v, want %v", n, 0)
+	}
+	if h.Undo() {
+		t.Errorf("Undo() = %v after no-op mutations, want %v", true, false)
+	}
+
+	h = NewHistorySequence[int]()
+	if _, err := h.Pop(); err != collection.EmptyCollectionError {
+		t.Errorf("Pop() error = %v, want %v", err, collection.EmptyCollectionError)
+	}
+	if h.Undo() {
+		t.Errorf("Undo() = %v after a failed Pop(), want %v", true, false)
+	}
+}
+
+func TestHistorySequence_Checkpoint(t *testing.T) {
+	h := NewHistorySequence([]int{1, 2, 3})
+	cp := h.Checkpoint()
+	h.RemoveWhere(func(i int) bool { return i%2 == 1 })
+	h.Pop()
+	h.Clear()
+	h.Add(9)
+
+	if err := h.RollbackTo(cp); err != nil {
+		t.Fatalf("RollbackTo() error = %v", err)
+	}
+	if got := h.ToSlice(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("RollbackTo() = %v, want %v", got, []int{1, 2, 3})
+	}
+	h.Undo()
+	if got := h.ToSlice(); !slices.Equal(got, []int{9}) {
+		t.Errorf("Undo() after RollbackTo() = %v, want %v", got, []int{9})
+	}
+	if err := h.RollbackTo(cp + 1); err != collection.InvalidArgumentError {
+		t.Errorf("RollbackTo() error = %v, want %v", err, collection.InvalidArgumentError)
+	}
+}
+
+func TestHistorySequence_SnapshotsAreIndependent(t *testing.T) {
+	h := NewHistorySequence([]int{1, 2, 3})
+	cp := h.Checkpoint()
+	h.Apply(func(i int) int { return i * 10 })
+	h.RollbackTo(cp)
+	h.Apply(func(i int) int { return i + 1 })
+	h.Undo()
+	h.Undo()
+	if got := h.ToSlice(); !slices.Equal(got, []int{10, 20, 30}) {
+		t.Errorf("ToSlice() = %v, want %v", got, []int{10, 20, 30})
+	}
+	h.RollbackTo(cp)
+	if got := h.ToSlice(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("RollbackTo() = %v, want %v", got, []int{1, 2, 3})
+	}
+}
@@ -34,6 +34,36 @@ func NewSequence[T any](s ...[]T) *Sequence[T] {
 	return &Sequence[T]{elements: slices.Concat(s...)}
 }
 
+// NewSequenceOf is a constructor for a sequence holding the given values.
+func NewSequenceOf[T any](v ...T) *Sequence[T] {
+	return &Sequence[T]{elements: slices.Clone(v)}
+}
+
+// NewSequenceJoining is a constructor for a sequence holding the elements of all parts,
+// with the separator inserted between consecutive parts. The sequence is allocated once.
+//
+// example usage:
+//
+//	NewSequenceJoining("|", []string{"a", "b"}, []string{"c"})
+//
+// output:
+//
+//	Seq(string) [a b | c]
+func NewSequenceJoining[T any](sep T, parts ...[]T) *Sequence[T] {
+	n := max(len(parts)-1, 0)
+	for _, p := range parts {
+		n += len(p)
+	}
+	elements := make([]T, 0, n)
+	for i, p := range parts {
+		if i > 0 {
+			elements = append(elements, sep)
+		}
+		elements = append(elements, p...)
+	}
+	return &Sequence[T]{elements: elements}
+}
+
 // The following methods implement
 // the Collection interface.
 
@@ -53,6 +83,9 @@ func (c *Sequence[T]) New(s ...[]T) collection.Collection[T] {
 }
 
 // Random returns a random element from the sequence.
+//
+// Deprecated: Random returns the zero value when the sequence is empty. Use Choice instead,
+// which returns an EmptyCollectionError for every collection type.
 func (c *Sequence[T]) Random() T {
 	if len(c.elements) == 0 {
 		return *new(T)
@@ -60,6 +93,11 @@ func (c *Sequence[T]) Random() T {
 	return c.elements[rand.Intn(len(c.elements))]
 }
 
+// Choice is an alias for collection.Choice
+func (c *Sequence[T]) Choice() (T, error) {
+	return collection.Choice(c)
+}
+
 // Values returns an iterator over all values of the underlying slice.
 func (c *Sequence[T]) Values() iter.Seq[T] {
 	return slices.Values(c.elements)
@@ -98,6 +136,27 @@ func (c *Sequence[T]) NewOrdered(s ...[]T) collection.OrderedCollection[T] {
 	return NewSequence(s...)
 }
 
+// The following methods implement
+// the MutableCollection interface.
+
+// AddAll appends all the given values to the sequence.
+func (c *Sequence[T]) AddAll(v ...T) {
+	c.elements = append(c.elements, v...)
+}
+
+// Clear removes all elements from the sequence.
+func (c *Sequence[T]) Clear() {
+	c.elements = nil
+}
+
+// RemoveWhere removes all elements that satisfy the predicate from the sequence
+// and returns the number of elements removed.
+func (c *Sequence[T]) RemoveWhere(f func(T) bool) int {
+	n := len(c.elements)
+	c.elements = slices.DeleteFunc(c.elements, f)
+	return n - len(c.elements)
+}
+
 // Apply applies a function to each element in the sequence.
 func (c *Sequence[T]) Apply(f func(T) T) *Sequence[T] {
 	for i := range c.elements {
@@ -106,10 +165,203 @@ func (c *Sequence[T]) Apply(f func(T) T) *Sequence[T] {
 	return c
 }
 
+// ApplyChanged applies a function to each element in the sequence and returns the number
+// of elements changed, which allows callers to detect passes that changed nothing.
+// The function returns the new value and whether it differs from the original,
+// elements for which it returns false are left untouched.
+func (c *Sequence[T]) ApplyChanged(f func(T) (T, bool)) int {
+	n := 0
+	for i := range c.elements {
+		if v, changed := f(c.elements[i]); changed {
+			c.elements[i] = v
+			n++
+		}
+	}
+	return n
+}
+
+// ApplyIndexed applies a function to each element and its index in the sequence.
+func (c *Sequence[T]) ApplyIndexed(f func(int, T) T) *Sequence[T] {
+	for i := range c.elements {
+		c.elements[i] = f(i, c.elements[i])
+	}
+	return c
+}
+
+// ApplyWhere applies a function to each element in the sequence
+// that satisfies the predicate, other elements are left untouched.
+func (c *Sequence[T]) ApplyWhere(p func(T) bool, f func(T) T) *Sequence[T] {
+	for i := range c.elements {
+		if p(c.elements[i]) {
+			c.elements[i] = f(c.elements[i])
+		}
+	}
+	return c
+}
+
+// ApplyWhereIndexed is similar to ApplyWhere but passes the index of each element
+// to both the predicate and the function.
+func (c *Sequence[T]) ApplyWhereIndexed(p func(int, T) bool, f func(int, T) T) *Sequence[T] {
+	for i := range c.elements {
+		if p(i, c.elements[i]) {
+			c.elements[i] = f(i, c.elements[i])
+		}
+	}
+	return c
+}
+
+// FillRange sets the elements from the start index to the end index (exclusive) to v.
+// It panics with an IndexOutOfBoundsError if the range is invalid.
+func (c *Sequence[T]) FillRange(start, end int, v T) *Sequence[T] {
+	if start < 0 || end > len(c.elements) || start > end {
+		panic(collection.IndexOutOfBoundsError)
+	}
+	for i := start; i < end; i++ {
+		c.elements[i] = v
+	}
+	return c
+}
+
+// RemoveRange removes the elements from the start index to the end index (exclusive)
+// in place, shifting the following elements with a single copy.
+// It panics with an IndexOutOfBoundsError if the range is invalid.
+func (c *Sequence[T]) RemoveRange(start, end int) *Sequence[T] {
+	if start < 0 || end > len(c.elements) || start > end {
+		panic(collection.IndexOutOfBoundsError)
+	}
+	c.elements = slices.Delete(c.elements, start, end)
+	return c
+}
+
+// RetainRange keeps only the elements from the start index to the end index (exclusive)
+// in place, moving them to the front of the sequence with a single copy.
+// Removed elements are zeroed so they can be garbage collected.
+// It panics with an IndexOutOfBoundsError if the range is invalid.
+func (c *Sequence[T]) RetainRange(start, end int) *Sequence[T] {
+	if start < 0 || end > len(c.elements) || start > end {
+		panic(collection.IndexOutOfBoundsError)
+	}
+	n := copy(c.elements, c.elements[start:end])
+	clear(c.elements[n:])
+	c.elements = c.elements[:n]
+	return c
+}
+
+// ReplaceAllFunc replaces every element that satisfies the predicate with v
+// and returns the number of elements replaced.
+func (c *Sequence[T]) ReplaceAllFunc(p func(T) bool, v T) int {
+	n := 0
+	for i := range c.elements {
+		if p(c.elements[i]) {
+			c.elements[i] = v
+			n++
+		}
+	}
+	return n
+}
+
+// Truncate keeps the first n elements of the sequence in place, without allocating.
+// Removed elements are zeroed so they can be garbage collected.
+// If n is not positive the sequence becomes empty.
+func (c *Sequence[T]) Truncate(n int) *Sequence[T] {
+	n = max(min(n, len(c.elements)), 0)
+	clear(c.elements[n:])
+	c.elements = c.elements[:n]
+	return c
+}
+
+// KeepLast keeps the last n elements of the sequence in place, without allocating,
+// which makes it suitable for rolling buffers. Removed elements are zeroed so they can be
+// garbage collected, the memory they occupied is released once appending to the sequence
+// grows its capacity, or immediately by calling Shrink.
+// If n is not positive the sequence becomes empty.
+func (c *Sequence[T]) KeepLast(n int) *Sequence[T] {
+	n = max(min(n, len(c.elements)), 0)
+	drop := len(c.elements) - n
+	clear(c.elements[:drop])
+	c.elements = c.elements[drop:]
+	return c
+}
+
+// Grow increases the capacity of the sequence, if necessary, so that n more
+// elements can be added without another allocation.
+// It panics with an InvalidArgumentError if n is negative.
+func (c *Sequence[T]) Grow(n int) *Sequence[T] {
+	if n < 0 {
+		panic(collection.InvalidArgumentError)
+	}
+	c.elements = slices.Grow(c.elements, n)
+	return c
+}
+
+// Shrink reallocates the underlying slice of the sequence to fit its length,
+// releasing any unused capacity.
+func (c *Sequence[T]) Shrink() *Sequence[T] {
+	if cap(c.elements) > len(c.elements) {
+		c.elements = slices.Clone(c.elements)
+	}
+	return c
+}
+
+// StablePartitionInPlace rearranges the sequence in place so that the elements satisfying
+// the predicate precede the ones that do not, preserving the relative order within both
+// groups, and returns the index of the first element that does not satisfy the predicate.
+// It does not allocate, and runs in O(n log n) time, calling the predicate once per element.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,3,4,5,6})
+//	i := c.StablePartitionInPlace(func(i int) bool { return i%2 == 0 })
+//	c.ToSlice()[:i], c.ToSlice()[i:]
+//
+// output:
+//
+//	[2,4,6], [1,3,5]
+func (c *Sequence[T]) StablePartitionInPlace(f func(T) bool) int {
+	return stablePartition(c.elements, f)
+}
+
+// stablePartition partitions both halves of s recursively then
+// rotates the two middle groups into place.
+func stablePartition[T any](s []T, f func(T) bool) int {
+	switch len(s) {
+	case 0:
+		return 0
+	case 1:
+		if f(s[0]) {
+			return 1
+		}
+		return 0
+	}
+	mid := len(s) / 2
+	left := stablePartition(s[:mid], f)
+	right := mid + stablePartition(s[mid:], f)
+	rotateLeft(s[left:right], mid-left)
+	return left + right - mid
+}
+
+// rotateLeft rotates s left by k positions using three reversals.
+func rotateLeft[T any](s []T, k int) {
+	slices.Reverse(s[:k])
+	slices.Reverse(s[k:])
+	slices.Reverse(s)
+}
+
 // The following methods are mostly syntatic sugar
 // wrapping Collection functions to enable function chaining:
 // i.e. sequence.Filter(f).Take(n)
 
+// Chunks is an alias for collection.Chunks
+func (c *Sequence[T]) Chunks(size int) iter.Seq2[collection.PageInfo, *Sequence[T]] {
+	return func(yield func(collection.PageInfo, *Sequence[T]) bool) {
+		for info, chunk := range collection.Chunks(c, size) {
+			if !yield(info, collection.MustCast[*Sequence[T]]("Chunks", chunk)) {
+				return
+			}
+		}
+	}
+}
+
 // Clone returns a copy of the collection. This is a shallow clone.
 func (c *Sequence[T]) Clone() *Sequence[T] {
 	return &Sequence[T]{
@@ -117,6 +369,39 @@ func (c *Sequence[T]) Clone() *Sequence[T] {
 	}
 }
 
+// Snapshot is a copy of the elements of a sequence taken by Sequence.Snapshot,
+// which can be restored any number of times with Sequence.RestoreFrom.
+type Snapshot[T any] struct {
+	elements []T
+}
+
+// Snapshot returns a shallow copy of the elements of the sequence, allowing
+// speculative algorithms such as backtracking searches to revert the sequence
+// to its current state with RestoreFrom. Mutating the sequence does not affect the snapshot.
+//
+// example usage:
+//
+//	c := NewSequence([]int{1,2,3})
+//	s := c.Snapshot()
+//	c.Add(4)
+//	c.RestoreFrom(s)
+//
+// output:
+//
+//	Seq(int) [1 2 3]
+func (c *Sequence[T]) Snapshot() Snapshot[T] {
+	return Snapshot[T]{elements: slices.Clone(c.elements)}
+}
+
+// RestoreFrom replaces the elements of the sequence with the elements of the snapshot.
+// It reuses the underlying slice of the sequence when its capacity suffices, so that
+// repeatedly restoring a snapshot does not allocate, and leaves the snapshot unchanged.
+func (c *Sequence[T]) RestoreFrom(s Snapshot[T]) *Sequence[T] {
+	clear(c.elements[min(len(s.elements), len(c.elements)):])
+	c.elements = append(c.elements[:0], s.elements...)
+	return c
+}
+
 // Count is an alias for collection.Count
 func (c *Sequence[T]) Count(f func(T) bool) int {
 	return collection.Count(c, f)
@@ -157,9 +442,23 @@ func (c *Sequence[T]) Dequeue() (T, error) {
 	return element, nil
 }
 
+// Drain returns an iterator that dequeues elements from the front of the sequence as it yields them.
+// Elements added to the sequence while draining are also yielded, iteration ends once the sequence is empty.
+// If iteration stops early, the remaining elements stay in the sequence.
+func (c *Sequence[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for len(c.elements) > 0 {
+			v, _ := c.Dequeue()
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
 // Diff is an alias for collection.Diff
 func (c *Sequence[T]) Diff(s *Sequence[T], f func(T, T) bool) *Sequence[T] {
-	return collection.DiffFunc(c, s, f).(*Sequence[T])
+	return collection.MustCast[*Sequence[T]]("Diff", collection.DiffFunc(c, s, f))
 }
 
 // Diffed is an alias for collection.Diffed
@@ -171,7 +470,7 @@ func (c *Sequence[T]) Diffed(s *Sequence[T], f func(T, T) bool) iter.Seq[T] {
 // and returns a new sequence containing all the unique elements
 // If you prefer not to pass an equality function use a ComparableSequence.
 func (c *Sequence[T]) Distinct(f func(T, T) bool) *Sequence[T] {
-	return collection.Distinct(c, f).(*Sequence[T])
+	return collection.MustCast[*Sequence[T]]("Distinct", collection.Distinct(c, f))
 }
 
 // DistinctIterator is an alias for collection.DistinctIterator
@@ -181,17 +480,24 @@ func (c *Sequence[T]) Distincted(f func(T, T) bool) iter.Seq[T] {
 
 // Drop is an alias for collection.Drop
 func (c *Sequence[T]) Drop(n int) *Sequence[T] {
-	return collection.Drop(c, n).(*Sequence[T])
+	return collection.MustCast[*Sequence[T]]("Drop", collection.Drop(c, n))
 }
 
 // DropWhile is an alias for collection.DropWhile
 func (c *Sequence[T]) DropWhile(f func(T) bool) *Sequence[T] {
-	return collection.DropWhile(c, f).(*Sequence[T])
+	return collection.MustCast[*Sequence[T]]("DropWhile", collection.DropWhile(c, f))
 }
 
 // DropRight is an alias for collection.DropRight
 func (c *Sequence[T]) DropRight(n int) *Sequence[T] {
-	return collection.DropRight(c, n).(*Sequence[T])
+	return collection.MustCast[*Sequence[T]]("DropRight", collection.DropRight(c, n))
+}
+
+// EndsWith takes a sequence and an equality function as an argument
+// and returns true if the sequence ends with the given sequence.
+// If you prefer not to pass an equality function use a ComparableSequence.
+func (c *Sequence[T]) EndsWith(other *Sequence[T], f func(T, T) bool) bool {
+	return collection.EndsWithFunc(c, other, f)
 }
 
 // Enqueue appends an element to the sequence.
@@ -199,6 +505,11 @@ func (c *Sequence[T]) Enqueue(v T) {
 	c.elements = append(c.elements, v)
 }
 
+// EqualsDeep is an alias for collection.EqualsDeep
+func (c *Sequence[T]) EqualsDeep(c2 *Sequence[T], comparers ...collection.DeepComparer) bool {
+	return collection.EqualsDeep(c, c2, comparers...)
+}
+
 // Equals takes a sequence and an equality function as an argument
 // and returns true if the two sequences are equal.
 // If you prefer not to pass an equality function use a ComparableSequence.
@@ -213,7 +524,7 @@ func (c *Sequence[T]) Exists(f func(T) bool) bool {
 
 // Filter is an alias for collection.Filter
 func (c *Sequence[T]) Filter(f func(T) bool) *Sequence[T] {
-	return collection.Filter(c, f).(*Sequence[T])
+	return collection.MustCast[*Sequence[T]]("Filter", collection.Filter(c, f))
 }
 
 // FilterIterator is an alias for collection.FilterIterator
@@ -223,7 +534,7 @@ func (c *Sequence[T]) Filtered(f func(T) bool) iter.Seq[T] {
 
 // FilterNot is an alias for collection.FilterNot
 func (c *Sequence[T]) FilterNot(f func(T) bool) *Sequence[T] {
-	return collection.FilterNot(c, f).(*Sequence[T])
+	return collection.MustCast[*Sequence[T]]("FilterNot", collection.FilterNot(c, f))
 }
 
 // Find is an alias for collection.Find
@@ -231,29 +542,61 @@ func (c *Sequence[T]) Find(f func(T) bool) (int, T) {
 	return collection.Find(c, f)
 }
 
+// FindAll is an alias for collection.FindAll
+func (c *Sequence[T]) FindAll(f func(T) bool) ([]int, *Sequence[T]) {
+	indices, values := collection.FindAll(c, f)
+	return indices, collection.MustCast[*Sequence[T]]("FindAll", values)
+}
+
 // FindLast is an alias for collection.FindLast
 func (c *Sequence[T]) FindLast(f func(T) bool) (int, T) {
 	return collection.FindLast(c, f)
 }
 
+// FindOr is an alias for collection.FindOr
+func (c *Sequence[T]) FindOr(f func(T) bool, def T) T {
+	return collection.FindOr(c, f, def)
+}
+
+// ForEachWhile is an alias for collection.ForEachWhile
+func (c *Sequence[T]) ForEachWhile(f func(T) bool) bool {
+	return collection.ForEachWhile(c, f)
+}
+
 // ForAll is an alias for collection.ForAll
 func (c *Sequence[T]) ForAll(f func(T) bool) bool {
 	return collection.ForAll(c, f)
 }
 
+// Freeze returns a read-only view of the sequence.
+func (c *Sequence[T]) Freeze() collection.ReadOnlyOrderedCollection[T] {
+	return collection.FreezeOrdered(c)
+}
+
 // Head is an alias for collection.Head
 func (c *Sequence[T]) Head() (T, error) {
 	return collection.Head(c)
 }
 
+// HeadOr is an alias for collection.HeadOr
+func (c *Sequence[T]) HeadOr(def T) T {
+	return collection.HeadOr(c, def)
+}
+
 // Init is an alias for collection.Init
 func (c *Sequence[T]) Init() *Sequence[T] {
-	return collection.Init(c).(*Sequence[T])
+	return collection.MustCast[*Sequence[T]]("Init", collection.Init(c))
+}
+
+// InspectEach is an alias for collection.InspectEach
+func (c *Sequence[T]) InspectEach(f func(int, T)) *Sequence[T] {
+	collection.InspectEach(c, f)
+	return c
 }
 
 // Intersect is an alias for collection.Intersect
 func (c *Sequence[T]) Intersect(s *Sequence[T], f func(T, T) bool) *Sequence[T] {
-	return collection.IntersectFunc(c, s, f).(*Sequence[T])
+	return collection.MustCast[*Sequence[T]]("Intersect", collection.IntersectFunc(c, s, f))
 }
 
 // IntersectIterator is an alias for collection.IntersectIterator
@@ -271,6 +614,31 @@ func (c *Sequence[T]) Last() (T, error) {
 	return collection.Last(c)
 }
 
+// LastOr is an alias for collection.LastOr
+func (c *Sequence[T]) LastOr(def T) T {
+	return collection.LastOr(c, def)
+}
+
+// MergeDistinctSorted is an alias for collection.MergeDistinctSorted
+func (c *Sequence[T]) MergeDistinctSorted(s *Sequence[T], less func(T, T) bool) *Sequence[T] {
+	return collection.MustCast[*Sequence[T]]("MergeDistinctSorted", collection.MergeDistinctSorted(c, s, less))
+}
+
+// MergeSorted is an alias for collection.MergeSorted
+func (c *Sequence[T]) MergeSorted(s *Sequence[T], less func(T, T) bool) *Sequence[T] {
+	return collection.MustCast[*Sequence[T]]("MergeSorted", collection.MergeSorted(c, s, less))
+}
+
+// MismatchIndex is an alias for collection.MismatchIndex
+func (c *Sequence[T]) MismatchIndex(s *Sequence[T], f func(T, T) bool) int {
+	return collection.MismatchIndex(c, s, f)
+}
+
+// None is an alias for collection.None
+func (c *Sequence[T]) None(f func(T) bool) bool {
+	return collection.None(c, f)
+}
+
 // returns true if the sequence is not empty.
 func (c *Sequence[T]) NonEmpty() bool {
 	return len(c.elements) > 0
@@ -291,27 +659,75 @@ func (c *Sequence[T]) Push(v T) {
 	c.elements = append(c.elements, v)
 }
 
+// Pairwise is an alias for collection.Pairwise
+func (c *Sequence[T]) Pairwise() iter.Seq2[T, T] {
+	return collection.Pairwise(c)
+}
+
+// Page is an alias for collection.Page
+func (c *Sequence[T]) Page(index, size int) (*Sequence[T], collection.PageInfo) {
+	page, info := collection.Page(c, index, size)
+	return collection.MustCast[*Sequence[T]]("Page", page), info
+}
+
 // Partition is an alias for collection.Partition
 func (c *Sequence[T]) Partition(f func(T) bool) (*Sequence[T], *Sequence[T]) {
 	left, right := collection.Partition(c, f)
-	return left.(*Sequence[T]), right.(*Sequence[T])
+	return collection.MustCast[*Sequence[T]]("Partition", left), collection.MustCast[*Sequence[T]]("Partition", right)
+}
+
+// Shard is an alias for collection.Shard
+func (c *Sequence[T]) Shard(n int) []*Sequence[T] {
+	shards := collection.Shard(c, n)
+	result := make([]*Sequence[T], len(shards))
+	for i, shard := range shards {
+		result[i] = collection.MustCast[*Sequence[T]]("Shard", shard)
+	}
+	return result
+}
+
+// SliceStep is an alias for collection.SliceStep
+func (c *Sequence[T]) SliceStep(start, end, step int) *Sequence[T] {
+	return collection.MustCast[*Sequence[T]]("SliceStep", collection.SliceStep(c, start, end, step))
+}
+
+// SliceSafe is an alias for collection.SliceSafe
+func (c *Sequence[T]) SliceSafe(start, end int) (*Sequence[T], error) {
+	s, err := collection.SliceSafe(c, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return collection.Cast[*Sequence[T]]("SliceSafe", s)
 }
 
-// SplitAt splits the sequence at the given index.
+// SplitAtSafe is an alias for collection.SplitAtSafe. Unlike SplitAt, the left sequence
+// holds the first n elements and n must be between 0 and Length().
+func (c *Sequence[T]) SplitAtSafe(n int) (*Sequence[T], *Sequence[T], error) {
+	left, right, err := collection.SplitAtSafe(c, n)
+	if err != nil {
+		return nil, nil, err
+	}
+	return collection.MustCast[*Sequence[T]]("SplitAtSafe", left), collection.MustCast[*Sequence[T]]("SplitAtSafe", right), nil
+}
+
+// SplitAt splits the sequence at the given index, the element at index n being part
+// of the left sequence. n is clamped to the range [-1, Length()-1], so that a negative
+// n leaves the left sequence empty and n >= Length()-1 leaves the right sequence empty.
 func (c *Sequence[T]) SplitAt(n int) (*Sequence[T], *Sequence[T]) {
-	left := NewSequence(c.elements[:n+1])
-	right := NewSequence(c.elements[n+1:])
+	i := min(max(n, -1), len(c.elements)-1) + 1
+	left := NewSequence(c.elements[:i])
+	right := NewSequence(c.elements[i:])
 	return left, right
 }
 
 // Reverse is an alias for collection.Reverse
 func (c *Sequence[T]) Reverse() *Sequence[T] {
-	return collection.Reverse(c).(*Sequence[T])
+	return collection.MustCast[*Sequence[T]]("Reverse", collection.Reverse(c))
 }
 
 // Reject is an alias for collection.FilterNot
 func (l *Sequence[T]) Reject(f func(T) bool) *Sequence[T] {
-	return collection.FilterNot(l, f).(*Sequence[T])
+	return collection.MustCast[*Sequence[T]]("Reject", collection.FilterNot(l, f))
 }
 
 // Rejected is an alias for collection.Rejected
@@ -319,31 +735,56 @@ func (c *Sequence[T]) Rejected(f func(T) bool) iter.Seq[T] {
 	return collection.Rejected(c, f)
 }
 
+// StartsWith takes a sequence and an equality function as an argument
+// and returns true if the sequence starts with the given sequence.
+// If you prefer not to pass an equality function use a ComparableSequence.
+func (c *Sequence[T]) StartsWith(other *Sequence[T], f func(T, T) bool) bool {
+	return collection.StartsWithFunc(c, other, f)
+}
+
 // String implements the Stringer interface.
 func (c *Sequence[T]) String() string {
 	return fmt.Sprintf("Seq(%T) %v", *new(T), c.elements)
 }
 
+// Tap is an alias for collection.Tap
+func (c *Sequence[T]) Tap(f func(collection.Collection[T])) *Sequence[T] {
+	collection.Tap(c, f)
+	return c
+}
+
 // Take is an alias for collection.Take
 func (c *Sequence[T]) Take(n int) *Sequence[T] {
-	return collection.Take(c, n).(*Sequence[T])
+	return collection.MustCast[*Sequence[T]]("Take", collection.Take(c, n))
 }
 
 // TakeRight is an alias for collection.TakeRight
 func (c *Sequence[T]) TakeRight(n int) *Sequence[T] {
-	return collection.TakeRight(c, n).(*Sequence[T])
+	return collection.MustCast[*Sequence[T]]("TakeRight", collection.TakeRight(c, n))
 }
 
 // Tail is an alias for collection.Tail
 func (c *Sequence[T]) Tail() *Sequence[T] {
-	return collection.Tail(c).(*Sequence[T])
+	return collection.MustCast[*Sequence[T]]("Tail", collection.Tail(c))
 }
 
-// ToSlice returns the underlying slice.
+// ToSlice returns the underlying slice. The returned slice aliases the sequence,
+// changes to its elements are visible in the sequence and vice versa.
+// Use ToSliceCopy to obtain an independent slice.
 func (c *Sequence[T]) ToSlice() []T {
 	return c.elements
 }
 
+// ToSliceCopy returns a copy of the underlying slice.
+func (c *Sequence[T]) ToSliceCopy() []T {
+	return slices.Clone(c.elements)
+}
+
+// AppendToSlice appends the elements of the sequence to dst and returns the extended slice.
+func (c *Sequence[T]) AppendToSlice(dst []T) []T {
+	return append(dst, c.elements...)
+}
+
 func (c *Sequence[T]) Shuffle() *Sequence[T] {
-	return collection.Shuffle(c).(*Sequence[T])
+	return collection.MustCast[*Sequence[T]]("Shuffle", collection.Shuffle(c))
 }
@@ -1,9 +1,12 @@
 package sequence
 
 import (
+	"math"
 	"reflect"
 	"slices"
 	"testing"
+
+	"github.com/charbz/gophers/collection"
 )
 
 func TestConcat(t *testing.T) {
@@ -761,3 +764,547 @@ func TestSequence_ShuffleDistribution(t *testing.T) {
 		}
 	}
 }
+
+func TestSequence_ApplyWhere(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice []int
+		pred  func(int) bool
+		want  []int
+	}{
+		{
+			name:  "apply to even elements",
+			slice: []int{1, 2, 3, 4},
+			pred:  func(i int) bool { return i%2 == 0 },
+			want:  []int{1, 20, 3, 40},
+		},
+		{
+			name:  "no matching elements",
+			slice: []int{1, 3},
+			pred:  func(i int) bool { return i%2 == 0 },
+			want:  []int{1, 3},
+		},
+		{
+			name:  "empty sequence",
+			slice: []int{},
+			pred:  func(i int) bool { return true },
+			want:  []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewSequence(tt.slice)
+			c.ApplyWhere(tt.pred, func(i int) int { return i * 10 })
+			if !slices.Equal(c.elements, tt.want) {
+				t.Errorf("ApplyWhere() = %v, want %v", c.elements, tt.want)
+			}
+		})
+	}
+}
+
+func TestSequence_ApplyChanged(t *testing.T) {
+	c := NewSequence([]int{1, 2, 3, 4})
+	roundUp := func(i int) (int, bool) {
+		if i%2 == 0 {
+			return i, false
+		}
+		return i + 1, true
+	}
+	if n := c.ApplyChanged(roundUp); n != 2 || !slices.Equal(c.elements, []int{2, 2, 4, 4}) {
+		t.Errorf("ApplyChanged() = %v %v, want %v %v", n, c.elements, 2, []int{2, 2, 4, 4})
+	}
+	if n := c.ApplyChanged(roundUp); n != 0 {
+		t.Errorf("ApplyChanged() = %v on a no-op pass, want %v", n, 0)
+	}
+}
+
+func TestSequence_ApplyIndexed(t *testing.T) {
+	c := NewSequence([]int{5, 5, 5})
+	c.ApplyIndexed(func(i int, v int) int { return v + i })
+	if !slices.Equal(c.elements, []int{5, 6, 7}) {
+		t.Errorf("ApplyIndexed() = %v, want %v", c.elements, []int{5, 6, 7})
+	}
+
+	c.ApplyWhereIndexed(
+		func(i int, v int) bool { return i > 0 },
+		func(i int, v int) int { return v * i },
+	)
+	if !slices.Equal(c.elements, []int{5, 6, 14}) {
+		t.Errorf("ApplyWhereIndexed() = %v, want %v", c.elements, []int{5, 6, 14})
+	}
+}
+
+func TestSequence_FindAll(t *testing.T) {
+	c := NewSequence([]int{1, 2, 3, 4, 5, 6})
+	indices, values := c.FindAll(func(i int) bool { return i > 3 })
+	if !slices.Equal(indices, []int{3, 4, 5}) {
+		t.Errorf("FindAll() indices = %v, want %v", indices, []int{3, 4, 5})
+	}
+	if got := values.Take(2).elements; !slices.Equal(got, []int{4, 5}) {
+		t.Errorf("FindAll().Take() = %v, want %v", got, []int{4, 5})
+	}
+}
+
+func TestNewSequenceOf(t *testing.T) {
+	values := []int{1, 2, 3}
+	c := NewSequenceOf(values...)
+	values[0] = 10
+	if !slices.Equal(c.elements, []int{1, 2, 3}) {
+		t.Errorf("NewSequenceOf() = %v, want %v", c.elements, []int{1, 2, 3})
+	}
+	if NewSequenceOf[int]().Length() != 0 {
+		t.Errorf("NewSequenceOf() with no values is not empty")
+	}
+}
+
+func TestSequence_MergeSorted(t *testing.T) {
+	a := NewSequence([]int{1, 3, 5})
+	b := NewSequence([]int{2, 4, 6})
+	got := a.MergeSorted(b, func(x, y int) bool { return x < y })
+	if !slices.Equal(got.elements, []int{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("MergeSorted() = %v, want %v", got.elements, []int{1, 2, 3, 4, 5, 6})
+	}
+}
+
+func TestSequence_Or(t *testing.T) {
+	c := NewSequence([]int{1, 2, 3})
+	empty := NewSequence[int]()
+	if c.HeadOr(0) != 1 || empty.HeadOr(0) != 0 {
+		t.Errorf("HeadOr() = %v, %v, want %v, %v", c.HeadOr(0), empty.HeadOr(0), 1, 0)
+	}
+	if c.LastOr(0) != 3 || empty.LastOr(0) != 0 {
+		t.Errorf("LastOr() = %v, %v, want %v, %v", c.LastOr(0), empty.LastOr(0), 3, 0)
+	}
+	if got := c.FindOr(func(i int) bool { return i > 1 }, 0); got != 2 {
+		t.Errorf("FindOr() = %v, want %v", got, 2)
+	}
+}
+
+func TestSequence_Tap(t *testing.T) {
+	var lengths []int
+	var inspected []int
+	got := NewSequence([]int{1, 2, 3, 4}).
+		Tap(func(c collection.Collection[int]) { lengths = append(lengths, c.Length()) }).
+		Filter(func(i int) bool { return i%2 == 0 }).
+		InspectEach(func(_ int, v int) { inspected = append(inspected, v) }).
+		Tap(func(c collection.Collection[int]) { lengths = append(lengths, c.Length()) })
+	if !slices.Equal(got.elements, []int{2, 4}) {
+		t.Errorf("Tap() changed the chain result to %v, want %v", got.elements, []int{2, 4})
+	}
+	if !slices.Equal(lengths, []int{4, 2}) || !slices.Equal(inspected, []int{2, 4}) {
+		t.Errorf("Tap() = %v, InspectEach() = %v, want %v, %v", lengths, inspected, []int{4, 2}, []int{2, 4})
+	}
+}
+
+func TestSequence_Freeze(t *testing.T) {
+	c := NewSequence([]int{1, 2, 3})
+	v := c.Freeze()
+	if _, ok := v.(*Sequence[int]); ok {
+		t.Errorf("Freeze() exposed the underlying sequence")
+	}
+	c.Add(4)
+	if v.Length() != 4 || v.At(3) != 4 {
+		t.Errorf("Freeze() view did not reflect changes to the sequence")
+	}
+}
+
+func TestSequence_StartsWithEndsWith(t *testing.T) {
+	eq := func(a, b []int) bool { return slices.Equal(a, b) }
+	c := NewSequence([][]int{{1}, {2, 3}, {4}})
+	if !c.StartsWith(NewSequence([][]int{{1}, {2, 3}}), eq) {
+		t.Errorf("StartsWith() = %v, want %v", false, true)
+	}
+	if c.StartsWith(NewSequence([][]int{{2, 3}}), eq) {
+		t.Errorf("StartsWith() = %v, want %v", true, false)
+	}
+	if !c.EndsWith(NewSequence([][]int{{2, 3}, {4}}), eq) {
+		t.Errorf("EndsWith() = %v, want %v", false, true)
+	}
+	if c.EndsWith(NewSequence([][]int{{1}}), eq) {
+		t.Errorf("EndsWith() = %v, want %v", true, false)
+	}
+}
+
+func TestSequence_ToSliceCopy(t *testing.T) {
+	c := NewSequence([]int{1, 2, 3})
+	s := c.ToSliceCopy()
+	s[0] = 10
+	if c.At(0) != 1 {
+		t.Errorf("ToSliceCopy() aliased the sequence, At(0) = %v, want %v", c.At(0), 1)
+	}
+	if !slices.Equal(s, []int{10, 2, 3}) {
+		t.Errorf("ToSliceCopy() = %v, want %v", s, []int{10, 2, 3})
+	}
+}
+
+func TestSequence_AppendToSlice(t *testing.T) {
+	c := NewSequence([]int{2, 3})
+	buf := make([]int, 0, 4)
+	got := c.AppendToSlice(append(buf, 1))
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("AppendToSlice() = %v, want %v", got, []int{1, 2, 3})
+	}
+	if &got[0] != &buf[:1][0] {
+		t.Errorf("AppendToSlice() did not reuse the destination buffer")
+	}
+}
+
+func TestSequence_Drain(t *testing.T) {
+	c := NewSequence([]int{1, 2, 3})
+	var got []int
+	for v := range c.Drain() {
+		got = append(got, v)
+		if v == 1 {
+			c.Enqueue(4)
+		}
+	}
+	if !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("Drain() = %v, want %v", got, []int{1, 2, 3, 4})
+	}
+	if c.Length() != 0 {
+		t.Errorf("Drain() left %v elements, want %v", c.Length(), 0)
+	}
+
+	c = NewSequence([]int{1, 2, 3})
+	for v := range c.Drain() {
+		if v == 2 {
+			break
+		}
+	}
+	if !slices.Equal(c.ToSlice(), []int{3}) {
+		t.Errorf("Drain() remaining = %v, want %v", c.ToSlice(), []int{3})
+	}
+}
+
+func TestSequence_FillRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end int
+		want       []int
+	}{
+		{name: "middle", start: 1, end: 3, want: []int{1, 0, 0, 4}},
+		{name: "whole sequence", start: 0, end: 4, want: []int{0, 0, 0, 0}},
+		{name: "empty range", start: 2, end: 2, want: []int{1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewSequence([]int{1, 2, 3, 4}).FillRange(tt.start, tt.end, 0)
+			if !slices.Equal(got.elements, tt.want) {
+				t.Errorf("FillRange() = %v, want %v", got.elements, tt.want)
+			}
+		})
+	}
+	defer func() {
+		if r := recover(); r != collection.IndexOutOfBoundsError {
+			t.Errorf("FillRange() panic = %v, want %v", r, collection.IndexOutOfBoundsError)
+		}
+	}()
+	NewSequence([]int{1, 2}).FillRange(1, 3, 0)
+}
+
+func TestSequence_RemoveRetainRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end int
+		removed    []int
+		retained   []int
+	}{
+		{name: "middle", start: 1, end: 3, removed: []int{1, 4, 5}, retained: []int{2, 3}},
+		{name: "prefix", start: 0, end: 2, removed: []int{3, 4, 5}, retained: []int{1, 2}},
+		{name: "suffix", start: 3, end: 5, removed: []int{1, 2, 3}, retained: []int{4, 5}},
+		{name: "whole sequence", start: 0, end: 5, removed: []int{}, retained: []int{1, 2, 3, 4, 5}},
+		{name: "empty range", start: 2, end: 2, removed: []int{1, 2, 3, 4, 5}, retained: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backing := []int{1, 2, 3, 4, 5}
+			got := FromSlice(backing).RemoveRange(tt.start, tt.end)
+			if !slices.Equal(got.elements, tt.removed) {
+				t.Errorf("RemoveRange() = %v, want %v", got.elements, tt.removed)
+			}
+			for _, v := range backing[len(tt.removed):] {
+				if v != 0 {
+					t.Errorf("RemoveRange() did not zero removed elements, got %v", backing)
+				}
+			}
+			backing = []int{1, 2, 3, 4, 5}
+			got = FromSlice(backing).RetainRange(tt.start, tt.end)
+			if !slices.Equal(got.elements, tt.retained) {
+				t.Errorf("RetainRange() = %v, want %v", got.elements, tt.retained)
+			}
+			for _, v := range backing[len(tt.retained):] {
+				if v != 0 {
+					t.Errorf("RetainRange() did not zero removed elements, got %v", backing)
+				}
+			}
+		})
+	}
+	defer func() {
+		if r := recover(); r != collection.IndexOutOfBoundsError {
+			t.Errorf("RemoveRange() panic = %v, want %v", r, collection.IndexOutOfBoundsError)
+		}
+	}()
+	NewSequence([]int{1, 2}).RemoveRange(2, 1)
+}
+
+func TestSequence_ReplaceAllFunc(t *testing.T) {
+	c := NewSequence([]int{1, -2, 3, -4})
+	n := c.ReplaceAllFunc(func(i int) bool { return i < 0 }, 0)
+	if n != 2 || !slices.Equal(c.elements, []int{1, 0, 3, 0}) {
+		t.Errorf("ReplaceAllFunc() = %v %v, want %v %v", n, c.elements, 2, []int{1, 0, 3, 0})
+	}
+}
+
+func TestSequence_SliceStep(t *testing.T) {
+	c := NewSequence([]int{0, 1, 2, 3, 4, 5})
+	if got := c.SliceStep(math.MaxInt, math.MinInt, -2).ToSlice(); !slices.Equal(got, []int{5, 3, 1}) {
+		t.Errorf("SliceStep() = %v, want %v", got, []int{5, 3, 1})
+	}
+}
+
+func TestNewSequenceJoining(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts [][]string
+		want  []string
+	}{
+		{name: "several parts", parts: [][]string{{"a", "b"}, {"c"}}, want: []string{"a", "b", "|", "c"}},
+		{name: "empty part", parts: [][]string{{"a"}, {}, {"b"}}, want: []string{"a", "|", "|", "b"}},
+		{name: "no parts", parts: nil, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewSequenceJoining("|", tt.parts...)
+			if !slices.Equal(got.elements, tt.want) || cap(got.elements) != len(tt.want) {
+				t.Errorf("NewSequenceJoining() = %v (cap %v), want %v", got.elements, cap(got.elements), tt.want)
+			}
+		})
+	}
+}
+
+func TestSequence_Pairwise(t *testing.T) {
+	var got []string
+	for prev, cur := range NewSequence([]string{"a", "b", "c"}).Pairwise() {
+		got = append(got, prev+cur)
+	}
+	if !slices.Equal(got, []string{"ab", "bc"}) {
+		t.Errorf("Pairwise() = %v, want %v", got, []string{"ab", "bc"})
+	}
+}
+
+func TestSequence_Truncate(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want []int
+	}{
+		{name: "keep first two", n: 2, want: []int{1, 2}},
+		{name: "n larger than length", n: 10, want: []int{1, 2, 3, 4}},
+		{name: "zero", n: 0, want: []int{}},
+		{name: "negative", n: -1, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backing := []int{1, 2, 3, 4}
+			c := FromSlice(backing).Truncate(tt.n)
+			if !slices.Equal(c.elements, tt.want) {
+				t.Errorf("Truncate() = %v, want %v", c.elements, tt.want)
+			}
+			for _, v := range backing[len(tt.want):] {
+				if v != 0 {
+					t.Errorf("Truncate() did not zero removed elements, got %v", backing)
+				}
+			}
+		})
+	}
+}
+
+func TestSequence_KeepLast(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		want []int
+	}{
+		{name: "keep last two", n: 2, want: []int{3, 4}},
+		{name: "n larger than length", n: 10, want: []int{1, 2, 3, 4}},
+		{name: "zero", n: 0, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backing := []int{1, 2, 3, 4}
+			c := FromSlice(backing).KeepLast(tt.n)
+			if !slices.Equal(c.elements, tt.want) {
+				t.Errorf("KeepLast() = %v, want %v", c.elements, tt.want)
+			}
+			for _, v := range backing[:len(backing)-len(tt.want)] {
+				if v != 0 {
+					t.Errorf("KeepLast() did not zero removed elements, got %v", backing)
+				}
+			}
+		})
+	}
+}
+
+func TestSequence_RollingBuffer(t *testing.T) {
+	c := NewSequence[int]()
+	for i := range 100 {
+		c.Add(i)
+		c.KeepLast(3)
+	}
+	if !slices.Equal(c.elements, []int{97, 98, 99}) {
+		t.Errorf("KeepLast() = %v, want %v", c.elements, []int{97, 98, 99})
+	}
+	if cap(c.Shrink().elements) != 3 {
+		t.Errorf("Shrink() cap = %v, want %v", cap(c.elements), 3)
+	}
+}
+
+func TestSequence_Grow(t *testing.T) {
+	c := NewSequence([]int{1, 2})
+	if got := cap(c.Grow(10).elements); got < 12 {
+		t.Errorf("Grow() cap = %v, want at least %v", got, 12)
+	}
+	if !slices.Equal(c.elements, []int{1, 2}) {
+		t.Errorf("Grow() = %v, want %v", c.elements, []int{1, 2})
+	}
+	defer func() {
+		if r := recover(); r != collection.InvalidArgumentError {
+			t.Errorf("Grow() panic = %v, want %v", r, collection.InvalidArgumentError)
+		}
+	}()
+	c.Grow(-1)
+}
+
+func TestSequence_SnapshotRestore(t *testing.T) {
+	c := NewSequence([]int{1, 2, 3})
+	snap := c.Snapshot()
+	c.Add(4)
+	c.elements[0] = 10
+	if got := c.RestoreFrom(snap).elements; !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("RestoreFrom() = %v, want %v", got, []int{1, 2, 3})
+	}
+	c.elements[1] = 20
+	c.Clear()
+	if got := c.RestoreFrom(snap).elements; !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("RestoreFrom() = %v after a second restore, want %v", got, []int{1, 2, 3})
+	}
+	c.Add(4)
+	if allocs := testing.AllocsPerRun(10, func() { c.RestoreFrom(snap) }); allocs != 0 {
+		t.Errorf("RestoreFrom() allocs = %v, want %v", allocs, 0)
+	}
+}
+
+func TestSequence_StablePartitionInPlace(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+	tests := []struct {
+		name  string
+		input []int
+		want  []int
+		index int
+	}{
+		{name: "mixed", input: []int{1, 2, 3, 4, 5, 6, 7}, want: []int{2, 4, 6, 1, 3, 5, 7}, index: 3},
+		{name: "all match", input: []int{2, 4, 6}, want: []int{2, 4, 6}, index: 3},
+		{name: "none match", input: []int{1, 3, 5}, want: []int{1, 3, 5}, index: 0},
+		{name: "already partitioned", input: []int{8, 2, 1, 9}, want: []int{8, 2, 1, 9}, index: 2},
+		{name: "reversed", input: []int{9, 7, 5, 3, 8, 6, 4, 2}, want: []int{8, 6, 4, 2, 9, 7, 5, 3}, index: 4},
+		{name: "empty", input: []int{}, want: []int{}, index: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewSequence(tt.input)
+			calls := 0
+			index := c.StablePartitionInPlace(func(i int) bool {
+				calls++
+				return isEven(i)
+			})
+			if index != tt.index || !slices.Equal(c.elements, tt.want) {
+				t.Errorf("StablePartitionInPlace() = %v, %v, want %v, %v", index, c.elements, tt.index, tt.want)
+			}
+			if calls != len(tt.input) {
+				t.Errorf("StablePartitionInPlace() called predicate %v times, want %v", calls, len(tt.input))
+			}
+		})
+	}
+}
+
+func TestSequence_ImplementsRemovableCollection(t *testing.T) {
+	var _ collection.RemovableCollection[int] = NewSequence[int]()
+	var _ collection.MutableCollection[int] = collection.WithRemove[int](NewSequence[int]())
+
+	type user struct {
+		name string
+		tags []string
+	}
+	c := NewSequence([]user{{"ann", nil}, {"bob", []string{"admin"}}})
+	var m collection.MutableCollection[user] = collection.WithRemoveFunc[user](c, func(a, b user) bool { return a.name == b.name })
+	if !m.Remove(user{name: "bob"}) || c.Length() != 1 {
+		t.Errorf("Remove() left %v, want %v", c.elements, []user{{"ann", nil}})
+	}
+}
+
+func TestSequence_PageChunks(t *testing.T) {
+	c := NewSequence([]int{1, 2, 3, 4, 5})
+	page, info := c.Page(1, 2)
+	if !slices.Equal(page.elements, []int{3, 4}) || info.TotalPages != 3 {
+		t.Errorf("Page() = %v, %+v, want %v", page.elements, info, []int{3, 4})
+	}
+	var chunks [][]int
+	for _, chunk := range c.Chunks(2) {
+		chunks = append(chunks, chunk.elements)
+	}
+	if want := [][]int{{1, 2}, {3, 4}, {5}}; !slices.EqualFunc(chunks, want, slices.Equal) {
+		t.Errorf("Chunks() = %v, want %v", chunks, want)
+	}
+}
+
+func TestSequence_SliceSafe(t *testing.T) {
+	c := NewSequence([]int{1, 2, 3})
+	if got, err := c.SliceSafe(0, 2); err != nil || !slices.Equal(got.elements, []int{1, 2}) {
+		t.Errorf("SliceSafe() = %v, %v, want %v, %v", got, err, []int{1, 2}, nil)
+	}
+	if _, err := c.SliceSafe(1, 5); err != collection.IndexOutOfBoundsError {
+		t.Errorf("SliceSafe() error = %v, want %v", err, collection.IndexOutOfBoundsError)
+	}
+}
+
+func TestSequence_SplitAt(t *testing.T) {
+	c := NewSequence([]int{1, 2, 3})
+	for _, tt := range []struct {
+		n           int
+		left, right []int
+	}{
+		{n: 1, left: []int{1, 2}, right: []int{3}},
+		{n: -5, left: []int{}, right: []int{1, 2, 3}},
+		{n: 7, left: []int{1, 2, 3}, right: []int{}},
+	} {
+		left, right := c.SplitAt(tt.n)
+		if !slices.Equal(left.elements, tt.left) || !slices.Equal(right.elements, tt.right) {
+			t.Errorf("SplitAt(%v) = %v, %v, want %v, %v", tt.n, left, right, tt.left, tt.right)
+		}
+	}
+}
+
+func TestSequence_SplitAtSafe(t *testing.T) {
+	c := NewSequence([]int{1, 2, 3})
+	left, right, err := c.SplitAtSafe(1)
+	if err != nil || !slices.Equal(left.elements, []int{1}) || !slices.Equal(right.elements, []int{2, 3}) {
+		t.Errorf("SplitAtSafe() = %v, %v, %v, want %v, %v, %v", left, right, err, []int{1}, []int{2, 3}, nil)
+	}
+	for _, n := range []int{-1, 4} {
+		if _, _, err := c.SplitAtSafe(n); err != collection.IndexOutOfBoundsError {
+			t.Errorf("SplitAtSafe(%v) error = %v, want %v", n, err, collection.IndexOutOfBoundsError)
+		}
+	}
+}
+
+func TestSequence_ForEachWhile(t *testing.T) {
+	sum := 0
+	completed := NewSequence([]int{1, 2, 3, 4}).ForEachWhile(func(i int) bool {
+		sum += i
+		return sum < 3
+	})
+	if completed || sum != 3 {
+		t.Errorf("ForEachWhile() = %v with sum %v, want %v with sum %v", completed, sum, false, 3)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package set
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"reflect"
+	"slices"
+	"sync/atomic"
+)
+
+// deterministic reports whether sets iterate in sorted order.
+var deterministic atomic.Bool
+
+// WithDeterministicIteration makes all sets iterate over their values in sorted order,
+// instead of the random order of the underlying map, and returns a function restoring
+// the previous behavior. It is meant for tests comparing output against golden files,
+// since sorting the values makes every iteration cost O(n log n).
+//
+// Integers, floats and strings, including named types, are sorted by value,
+// other values are sorted by their Go-syntax representation. Values holding
+// pointers, channels or functions are not supported, since their representation
+// is an address which changes between runs.
+//
+// example usage:
+//
+//	func TestReport(t *testing.T) {
+//		t.Cleanup(set.WithDeterministicIteration())
+//		fmt.Println(NewSet([]int{3,1,2}))
+//	}
+//
+// output:
+//
+//	Set(int) [1 2 3]
+func WithDeterministicIteration() (restore func()) {
+	previous := deterministic.Swap(true)
+	return func() { deterministic.Store(previous) }
+}
+
+// keys returns an iterator over the values of the set,
+// in sorted order if deterministic iteration is enabled.
+// The values are read when the iterator is ranged over, not when it is created.
+func (s *Set[T]) keys() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if !deterministic.Load() {
+			for k := range s.elements {
+				if !yield(k) {
+					return
+				}
+			}
+			return
+		}
+		sorted := make([]T, 0, len(s.elements))
+		for k := range s.elements {
+			sorted = append(sorted, k)
+		}
+		slices.SortFunc(sorted, func(a, b T) int { return compareValues(a, b) })
+		for _, k := range sorted {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// compareValues compares two values of the same type, by value for
+// ordered kinds and by their Go-syntax representation otherwise.
+func compareValues(a, b any) int {
+	x, y := reflect.ValueOf(a), reflect.ValueOf(b)
+	switch x.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(x.Int(), y.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(x.Uint(), y.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(x.Float(), y.Float())
+	case reflect.String:
+		return cmp.Compare(x.String(), y.String())
+	}
+	return cmp.Compare(fmt.Sprintf("%#v", a), fmt.Sprintf("%#v", b))
+}
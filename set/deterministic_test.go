@@ -0,0 +1,57 @@
+package set
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWithDeterministicIteration(t *testing.T) {
+	restore := WithDeterministicIteration()
+	defer restore()
+
+	ints := NewSet([]int{10, -3, 7, 2, 9, 1})
+	if got := ints.ToSlice(); !slices.Equal(got, []int{-3, 1, 2, 7, 9, 10}) {
+		t.Errorf("ToSlice() = %v, want %v", got, []int{-3, 1, 2, 7, 9, 10})
+	}
+	if got := ints.String(); got != "Set(int) [-3 1 2 7 9 10]" {
+		t.Errorf("String() = %v, want %v", got, "Set(int) [-3 1 2 7 9 10]")
+	}
+
+	a, b := NewSet([]string{"d", "b", "a"}), NewSet([]string{"c", "b", "e"})
+	if got := slices.Collect(a.Unioned(b)); !slices.Equal(got, []string{"a", "b", "d", "c", "e"}) {
+		t.Errorf("Unioned() = %v, want %v", got, []string{"a", "b", "d", "c", "e"})
+	}
+	if got := slices.Collect(a.DiffIterator(b)); !slices.Equal(got, []string{"a", "d"}) {
+		t.Errorf("DiffIterator() = %v, want %v", got, []string{"a", "d"})
+	}
+
+	type point struct{ x, y int }
+	points := NewSet([]point{{2, 1}, {1, 2}, {1, 1}})
+	for range 10 {
+		if got := points.ToSlice(); !slices.Equal(got, []point{{1, 1}, {1, 2}, {2, 1}}) {
+			t.Fatalf("ToSlice() = %v, want %v", got, []point{{1, 1}, {1, 2}, {2, 1}})
+		}
+	}
+}
+
+func TestWithDeterministicIteration_LateRange(t *testing.T) {
+	t.Cleanup(WithDeterministicIteration())
+	s := NewSet([]int{3, 1})
+	values := s.Values()
+	s.Add(2)
+	if got := slices.Collect(values); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Values() = %v, want %v", got, []int{1, 2, 3})
+	}
+}
+
+func TestWithDeterministicIteration_Restore(t *testing.T) {
+	restore := WithDeterministicIteration()
+	WithDeterministicIteration()()
+	if !deterministic.Load() {
+		t.Errorf("nested restore disabled deterministic iteration")
+	}
+	restore()
+	if deterministic.Load() {
+		t.Errorf("restore did not disable deterministic iteration")
+	}
+}
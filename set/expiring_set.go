@@ -0,0 +1,295 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package set
+
+import (
+	"fmt"
+	"iter"
+	"sync"
+	"time"
+
+	"github.com/charbz/gophers/collection"
+)
+
+// Clock provides the current time to an ExpiringSet,
+// allowing tests to control the passing of time.
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock is the Clock reading the system time.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// ExpiringSet is a set whose values expire after a fixed duration, the time to live,
+// counted from the last time they were added. It suits deduplication over a time window,
+// such as ignoring events seen during the last few minutes.
+//
+// Expired values are purged lazily whenever the set is accessed, so they are never
+// observed, and can also be purged periodically by a background sweep started with
+// StartSweep to release their memory. An ExpiringSet is safe for concurrent use.
+type ExpiringSet[T comparable] struct {
+	mu       sync.Mutex
+	elements map[T]time.Time
+	ttl      time.Duration
+	clock    Clock
+}
+
+// NewExpiringSet is a constructor for a set whose values expire after the time to live.
+// It panics with an InvalidArgumentError if ttl is not positive.
+//
+// example usage:
+//
+//	seen := NewExpiringSet[string](5*time.Minute)
+//	seen.AddIfAbsent("event-1")
+//	seen.AddIfAbsent("event-1")
+//
+// output:
+//
+//	true
+//	false
+func NewExpiringSet[T comparable](ttl time.Duration, s ...[]T) *ExpiringSet[T] {
+	return NewExpiringSetWithClock(ttl, systemClock{}, s...)
+}
+
+// NewExpiringSetWithClock is like NewExpiringSet but reads the current time from the clock.
+func NewExpiringSetWithClock[T comparable](ttl time.Duration, clock Clock, s ...[]T) *ExpiringSet[T] {
+	if ttl <= 0 {
+		panic(collection.InvalidArgumentError)
+	}
+	set := &ExpiringSet[T]{
+		elements: make(map[T]time.Time),
+		ttl:      ttl,
+		clock:    clock,
+	}
+	for _, slice := range s {
+		set.AddAll(slice...)
+	}
+	return set
+}
+
+// The following methods implement
+// the Collection interface.
+
+// Add adds a value to the set, or resets its expiration if it is already present.
+func (s *ExpiringSet[T]) Add(v T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.elements[v] = s.clock.Now().Add(s.ttl)
+}
+
+// Length returns the number of values that have not expired.
+func (s *ExpiringSet[T]) Length() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.purge()
+	return len(s.elements)
+}
+
+// New returns a new set with the same time to live and clock.
+func (s *ExpiringSet[T]) New(s2 ...[]T) collection.Collection[T] {
+	return NewExpiringSetWithClock(s.ttl, s.clock, s2...)
+}
+
+// Random returns an arbitrary value that has not expired.
+// It panics with an EmptyCollectionError if the set is empty,
+// use Choice to get an error instead.
+func (s *ExpiringSet[T]) Random() T {
+	v, err := s.Choice()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Choice returns an arbitrary value that has not expired,
+// or an EmptyCollectionError if the set is empty.
+// Expired values are purged and the value is picked under a single lock,
+// so a value expiring concurrently is never returned.
+func (s *ExpiringSet[T]) Choice() (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.purge()
+	for v := range s.elements {
+		return v, nil
+	}
+	return *new(T), collection.EmptyCollectionError
+}
+
+// Values returns an iterator over the values that have not expired when it is called.
+// The set may be modified during the iteration.
+func (s *ExpiringSet[T]) Values() iter.Seq[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.purge()
+	values := make([]T, 0, len(s.elements))
+	for v := range s.elements {
+		values = append(values, v)
+	}
+	return func(yield func(T) bool) {
+		for _, v := range values {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// The following methods implement
+// the MutableCollection interface.
+
+// AddAll adds all the given values to the set, resetting the
+// expiration of the values already present.
+func (s *ExpiringSet[T]) AddAll(v ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	expiry := s.clock.Now().Add(s.ttl)
+	for _, e := range v {
+		s.elements[e] = expiry
+	}
+}
+
+// Clear removes all values from the set.
+func (s *ExpiringSet[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clear(s.elements)
+}
+
+// Remove removes a value from the set and returns true if it was present and had not expired.
+func (s *ExpiringSet[T]) Remove(v T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ok := s.live(v)
+	delete(s.elements, v)
+	return ok
+}
+
+// RemoveWhere removes all the values that satisfy the predicate
+// and returns the number of values removed. The predicate is called
+// without holding the lock, so it may access the set.
+func (s *ExpiringSet[T]) RemoveWhere(f func(T) bool) int {
+	var matched []T
+	for v := range s.Values() {
+		if f(v) {
+			matched = append(matched, v)
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, v := range matched {
+		if s.live(v) {
+			delete(s.elements, v)
+			n++
+		}
+	}
+	return n
+}
+
+// The following methods are specific to the ExpiringSet type.
+
+// AddIfAbsent adds a value to the set if it is not present or has expired, and returns
+// true if it was added. Unlike Add, it does not reset the expiration of a present value,
+// so a value seen repeatedly is reported again once per time to live.
+func (s *ExpiringSet[T]) AddIfAbsent(v T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.live(v) {
+		return false
+	}
+	s.elements[v] = s.clock.Now().Add(s.ttl)
+	return true
+}
+
+// Contains returns true if the set contains the value and it has not expired.
+func (s *ExpiringSet[T]) Contains(v T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.live(v)
+}
+
+// ExpiresAt returns the time at which the value expires,
+// or false if the set does not contain the value.
+func (s *ExpiringSet[T]) ExpiresAt(v T) (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.live(v) {
+		return time.Time{}, false
+	}
+	return s.elements[v], true
+}
+
+// Purge removes the expired values from the set and returns the number of values removed.
+func (s *ExpiringSet[T]) Purge() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.purge()
+}
+
+// StartSweep starts a goroutine purging the expired values at every interval, which releases
+// the memory of values that are never accessed again, and returns a function stopping it.
+// It panics with an InvalidArgumentError if interval is not positive.
+//
+// example usage:
+//
+//	seen := NewExpiringSet[string](time.Minute)
+//	stop := seen.StartSweep(10*time.Second)
+//	defer stop()
+func (s *ExpiringSet[T]) StartSweep(interval time.Duration) (stop func()) {
+	if interval <= 0 {
+		panic(collection.InvalidArgumentError)
+	}
+	done := make(chan struct{})
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.Purge()
+			case <-done:
+				return
+			}
+		}
+	}()
+	var once sync.Once
+	return func() { once.Do(func() { close(done) }) }
+}
+
+// String implements the Stringer interface.
+func (s *ExpiringSet[T]) String() string {
+	var values []T
+	for v := range s.Values() {
+		values = append(values, v)
+	}
+	return fmt.Sprintf("ExpiringSet(%T) %v", *new(T), values)
+}
+
+// live reports whether the set contains the value and it has not expired,
+// deleting the value if it has expired. It must be called with the lock held.
+func (s *ExpiringSet[T]) live(v T) bool {
+	expiry, ok := s.elements[v]
+	if ok && !s.clock.Now().Before(expiry) {
+		delete(s.elements, v)
+		return false
+	}
+	return ok
+}
+
+// purge removes the expired values and returns their number.
+// It must be called with the lock held.
+func (s *ExpiringSet[T]) purge() int {
+	now := s.clock.Now()
+	n := 0
+	for v, expiry := range s.elements {
+		if !now.Before(expiry) {
+			delete(s.elements, v)
+			n++
+		}
+	}
+	return n
+}
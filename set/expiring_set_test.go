@@ -0,0 +1,144 @@
+package set
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/charbz/gophers/collection"
+)
+
+// fakeClock is a Clock advanced manually by tests.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestExpiringSet_ImplementsMutableCollection(t *testing.T) {
+	var _ collection.MutableCollection[int] = NewExpiringSet[int](time.Minute)
+}
+
+func TestExpiringSet_Expiration(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	s := NewExpiringSetWithClock(10*time.Second, clock, []string{"a", "b"})
+	clock.Advance(5 * time.Second)
+	s.Add("c")
+	s.Add("a") // resets the expiration of a
+	if s.Length() != 3 {
+		t.Errorf("Length() = %v, want %v", s.Length(), 3)
+	}
+	clock.Advance(5 * time.Second)
+	if s.Contains("b") {
+		t.Errorf("Contains(%q) = true after the time to live, want false", "b")
+	}
+	if got := slices.Sorted(s.Values()); !slices.Equal(got, []string{"a", "c"}) {
+		t.Errorf("Values() = %v, want %v", got, []string{"a", "c"})
+	}
+	if at, ok := s.ExpiresAt("c"); !ok || !at.Equal(time.Unix(15, 0)) {
+		t.Errorf("ExpiresAt() = %v, %v, want %v, %v", at, ok, time.Unix(15, 0), true)
+	}
+	clock.Advance(5 * time.Second)
+	if s.Length() != 0 || s.Remove("a") {
+		t.Errorf("Length() = %v after every value expired, want %v", s.Length(), 0)
+	}
+	if _, err := s.Choice(); err != collection.EmptyCollectionError {
+		t.Errorf("Choice() error = %v, want %v", err, collection.EmptyCollectionError)
+	}
+}
+
+func TestExpiringSet_AddIfAbsent(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	s := NewExpiringSetWithClock[int](time.Minute, clock)
+	var reported []int
+	for i := range 5 {
+		// the same event arrives every 20 seconds.
+		if s.AddIfAbsent(42) {
+			reported = append(reported, i)
+		}
+		clock.Advance(20 * time.Second)
+	}
+	if !slices.Equal(reported, []int{0, 3}) {
+		t.Errorf("AddIfAbsent() reported the event at %v, want %v", reported, []int{0, 3})
+	}
+}
+
+func TestExpiringSet_Purge(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	s := NewExpiringSetWithClock(time.Second, clock, []int{1, 2, 3})
+	clock.Advance(time.Second)
+	s.Add(4)
+	if n := s.Purge(); n != 3 || len(s.elements) != 1 {
+		t.Errorf("Purge() = %v leaving %v values, want %v leaving %v", n, len(s.elements), 3, 1)
+	}
+	if n := s.RemoveWhere(func(i int) bool { return i > 3 }); n != 1 {
+		t.Errorf("RemoveWhere() = %v, want %v", n, 1)
+	}
+}
+
+func TestExpiringSet_RemoveWhereReentrant(t *testing.T) {
+	s := NewExpiringSet(time.Minute, []int{1, 2, 3})
+	// the predicate accesses the set, which deadlocked when it was called under the lock.
+	n := s.RemoveWhere(func(i int) bool { return i > 1 && s.Contains(i) })
+	if n != 2 || s.Length() != 1 {
+		t.Errorf("RemoveWhere() = %v leaving %v values, want %v leaving %v", n, s.Length(), 2, 1)
+	}
+}
+
+func TestExpiringSet_ChoiceSkipsExpired(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	s := NewExpiringSetWithClock(time.Second, clock, []int{1})
+	clock.Advance(500 * time.Millisecond)
+	s.Add(2)
+	clock.Advance(500 * time.Millisecond)
+	if v, err := s.Choice(); err != nil || v != 2 {
+		t.Errorf("Choice() = %v, %v, want %v, %v", v, err, 2, nil)
+	}
+	clock.Advance(time.Second)
+	if _, err := s.Choice(); err != collection.EmptyCollectionError {
+		t.Errorf("Choice() error = %v, want %v", err, collection.EmptyCollectionError)
+	}
+}
+
+func TestExpiringSet_StartSweep(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	s := NewExpiringSetWithClock(time.Second, clock, []int{1, 2, 3})
+	stop := s.StartSweep(time.Millisecond)
+	defer stop()
+	clock.Advance(time.Second)
+	deadline := time.Now().Add(time.Second)
+	for {
+		s.mu.Lock()
+		n := len(s.elements)
+		s.mu.Unlock()
+		if n == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("StartSweep() left %v expired values", n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	stop()
+}
+
+func TestNewExpiringSet_InvalidTTL(t *testing.T) {
+	defer func() {
+		if r := recover(); r != collection.InvalidArgumentError {
+			t.Errorf("NewExpiringSet() panic = %v, want %v", r, collection.InvalidArgumentError)
+		}
+	}()
+	NewExpiringSet[int](0)
+}
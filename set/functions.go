@@ -0,0 +1,58 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// functions.go defines package functions that build sets from other
+// collections. They cannot live in the collection package, which the
+// set package depends on.
+
+package set
+
+import "github.com/charbz/gophers/collection"
+
+// GroupIntoSets groups the elements of a collection by the key returned by f
+// into sets, removing duplicates within each group in a single pass.
+//
+// example usage:
+//
+//	type Visit struct { User, Country string }
+//	visits := sequence.NewSequence([]Visit{{"ann", "FR"}, {"bob", "FR"}, {"ann", "FR"}, {"ann", "US"}})
+//	GroupIntoSets(visits, func(v Visit) string { return v.Country })
+//
+// output:
+//
+//	map[FR:Set{{ann FR}, {bob FR}} US:Set{{ann US}}]
+func GroupIntoSets[T, K comparable](s collection.Collection[T], f func(T) K) map[K]*Set[T] {
+	groups := make(map[K]*Set[T])
+	for v := range s.Values() {
+		k := f(v)
+		group, ok := groups[k]
+		if !ok {
+			group = NewSet[T]()
+			groups[k] = group
+		}
+		group.Add(v)
+	}
+	return groups
+}
+
+// MapTo applies a mapping function to each element of the collection
+// and returns a new set of the results, pre-sized for the number of elements.
+//
+// example usage:
+//
+//	names := NewSet([]string{"Alice", "Bob", "Charlie"})
+//	MapTo(names, func(name string) int {
+//	  return len(name)
+//	})
+//
+// output:
+//
+//	Set(int) [5 3 7]
+func MapTo[T any, K comparable](s collection.Collection[T], f func(T) K) *Set[K] {
+	set := &Set[K]{elements: make(map[K]struct{}, s.Length())}
+	for v := range s.Values() {
+		set.elements[f(v)] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,45 @@
+package set
+
+import (
+	"testing"
+
+	"github.com/charbz/gophers/sequence"
+)
+
+func TestGroupIntoSets(t *testing.T) {
+	type visit struct {
+		user    string
+		country string
+	}
+	visits := NewSet([]visit{
+		{"ann", "FR"}, {"bob", "FR"}, {"ann", "US"},
+	})
+	got := GroupIntoSets(visits, func(v visit) string { return v.country })
+	if len(got) != 2 {
+		t.Fatalf("GroupIntoSets() returned %v groups, want %v", len(got), 2)
+	}
+	if !got["FR"].Equals(NewSet([]visit{{"ann", "FR"}, {"bob", "FR"}})) {
+		t.Errorf("GroupIntoSets()[FR] = %v", got["FR"])
+	}
+	if !got["US"].Equals(NewSet([]visit{{"ann", "US"}})) {
+		t.Errorf("GroupIntoSets()[US] = %v", got["US"])
+	}
+}
+
+func TestGroupIntoSetsDedupes(t *testing.T) {
+	words := sequence.NewSequence([]string{"go", "rust", "go", "gleam", "rust", "zig"})
+	got := GroupIntoSets(words, func(w string) int { return len(w) })
+	want := map[int][]string{2: {"go"}, 4: {"rust"}, 5: {"gleam"}, 3: {"zig"}}
+	for k, values := range want {
+		if !assertEqualValues(got[k].ToSlice(), values) {
+			t.Errorf("GroupIntoSets()[%v] = %v, want %v", k, got[k].ToSlice(), values)
+		}
+	}
+}
+
+func TestMapTo(t *testing.T) {
+	got := MapTo(NewSet([]string{"Alice", "Bob", "Eve", "Charlie"}), func(s string) int { return len(s) })
+	if !assertEqualValues(got.ToSlice(), []int{5, 3, 7}) {
+		t.Errorf("MapTo() = %v, want %v", got.ToSlice(), []int{5, 3, 7})
+	}
+}
@@ -0,0 +1,63 @@
+package set
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/charbz/gophers/gopherstest"
+)
+
+// fuzzValue maps fuzz input bytes to a small range of values so that duplicates are common.
+func fuzzValue(b byte) int { return int(b % 8) }
+
+func FuzzSet_Mutations(f *testing.F) {
+	f.Add([]byte{0, 1, 1, 2, 0, 1, 2, 1, 3, 2, 4, 0, 0, 3})
+	f.Add([]byte{1, 255, 1, 7, 2, 7, 3, 127})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		gopherstest.AssertSameMutations(t, data, NewSet[int](), gopherstest.NewSetModel[int](nil), fuzzValue, false)
+	})
+}
+
+// FuzzSet_Operations compares the operations of sets with
+// reference implementations over plain maps.
+func FuzzSet_Operations(f *testing.F) {
+	f.Add([]byte{3, 1, 4, 1, 5}, []byte{9, 2, 6, 5, 3})
+	f.Add([]byte{}, []byte{7})
+	f.Fuzz(func(t *testing.T, a, b []byte) {
+		ma, mb := make(map[int]struct{}), make(map[int]struct{})
+		for _, v := range a {
+			ma[fuzzValue(v)] = struct{}{}
+		}
+		for _, v := range b {
+			mb[fuzzValue(v)] = struct{}{}
+		}
+		sa, sb := NewSet(keys(ma)), NewSet(keys(mb))
+
+		check := func(op string, got *Set[int], want map[int]struct{}) {
+			t.Helper()
+			if !maps.Equal(got.elements, want) {
+				t.Errorf("%s of %v and %v = %v, want %v", op, sa, sb, got, keys(want))
+			}
+		}
+		union, intersection, diff := maps.Clone(ma), make(map[int]struct{}), maps.Clone(ma)
+		maps.Copy(union, mb)
+		for v := range ma {
+			if _, ok := mb[v]; ok {
+				intersection[v] = struct{}{}
+				delete(diff, v)
+			}
+		}
+		check("Union", sa.Union(sb), union)
+		check("Intersection", sa.Intersection(sb), intersection)
+		check("Diff", sa.Diff(sb), diff)
+		check("original", sa, ma)
+	})
+}
+
+func keys(m map[int]struct{}) []int {
+	var s []int
+	for v := range m {
+		s = append(s, v)
+	}
+	return s
+}
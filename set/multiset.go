@@ -0,0 +1,320 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package set
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"maps"
+	"math/rand"
+	"slices"
+
+	"github.com/charbz/gophers/collection"
+)
+
+// MultiSet is an unordered collection which, unlike a Set, keeps duplicate values
+// by counting their occurrences. It is also known as a bag, and suits tallies and
+// histograms. Its length is the total number of occurrences.
+type MultiSet[T comparable] struct {
+	counts map[T]int
+	size   int
+}
+
+// NewMultiSet is a constructor for a multiset holding the values of the given slices.
+//
+// example usage:
+//
+//	m := NewMultiSet([]string{"a","b","a"})
+//	m.Count("a")
+//
+// output:
+//
+//	2
+func NewMultiSet[T comparable](s ...[]T) *MultiSet[T] {
+	m := &MultiSet[T]{counts: make(map[T]int)}
+	for _, slice := range s {
+		m.AddAll(slice...)
+	}
+	return m
+}
+
+// FromCounts is a constructor for a multiset holding every key of the map
+// as many times as its count. Keys with a count below 1 are ignored.
+func FromCounts[T comparable](counts map[T]int) *MultiSet[T] {
+	m := NewMultiSet[T]()
+	for v, n := range counts {
+		m.AddN(v, n)
+	}
+	return m
+}
+
+// The following methods implement
+// the Collection interface.
+
+// Add adds an occurrence of the value.
+func (m *MultiSet[T]) Add(v T) {
+	m.AddN(v, 1)
+}
+
+// Length returns the total number of occurrences in the multiset.
+func (m *MultiSet[T]) Length() int {
+	return m.size
+}
+
+// New returns a new multiset holding the values of the given slices.
+func (m *MultiSet[T]) New(s ...[]T) collection.Collection[T] {
+	return NewMultiSet(s...)
+}
+
+// Random returns a random value, chosen with a probability proportional to its count.
+// It panics with an EmptyCollectionError if the multiset is empty,
+// use Choice to get an error instead.
+func (m *MultiSet[T]) Random() T {
+	if m.size == 0 {
+		panic(collection.EmptyCollectionError)
+	}
+	i := rand.Intn(m.size)
+	for v, n := range m.counts {
+		if i < n {
+			return v
+		}
+		i -= n
+	}
+	panic(collection.EmptyCollectionError)
+}
+
+// Choice is an alias for collection.Choice
+func (m *MultiSet[T]) Choice() (T, error) {
+	return collection.Choice(m)
+}
+
+// Values returns an iterator over the values of the multiset, yielding
+// every value as many times as its count, with equal values consecutive.
+func (m *MultiSet[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v, n := range m.All() {
+			for range n {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// The following methods implement
+// the MutableCollection interface.
+
+// AddAll adds an occurrence of each of the given values.
+func (m *MultiSet[T]) AddAll(v ...T) {
+	for _, e := range v {
+		m.AddN(e, 1)
+	}
+}
+
+// Clear removes all values from the multiset.
+func (m *MultiSet[T]) Clear() {
+	clear(m.counts)
+	m.size = 0
+}
+
+// Remove removes one occurrence of the value and returns true if it was present.
+func (m *MultiSet[T]) Remove(v T) bool {
+	return m.RemoveN(v, 1) == 1
+}
+
+// RemoveWhere removes every occurrence of the values that satisfy
+// the predicate and returns the number of occurrences removed.
+func (m *MultiSet[T]) RemoveWhere(f func(T) bool) int {
+	removed := 0
+	for v, n := range m.counts {
+		if f(v) {
+			delete(m.counts, v)
+			removed += n
+		}
+	}
+	m.size -= removed
+	return removed
+}
+
+// The following methods are specific to the MultiSet type.
+
+// AddN adds n occurrences of the value and returns the multiset.
+// It does nothing if n is not positive.
+func (m *MultiSet[T]) AddN(v T, n int) *MultiSet[T] {
+	if n > 0 {
+		m.counts[v] += n
+		m.size += n
+	}
+	return m
+}
+
+// All returns an iterator over the distinct values of the multiset and their counts.
+// The iteration order follows the iteration order of sets, see WithDeterministicIteration.
+func (m *MultiSet[T]) All() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for _, v := range m.distinct() {
+			if !yield(v, m.counts[v]) {
+				return
+			}
+		}
+	}
+}
+
+// Clone returns a copy of the multiset.
+func (m *MultiSet[T]) Clone() *MultiSet[T] {
+	return &MultiSet[T]{counts: maps.Clone(m.counts), size: m.size}
+}
+
+// Contains returns true if the multiset holds at least one occurrence of the value.
+func (m *MultiSet[T]) Contains(v T) bool {
+	return m.counts[v] > 0
+}
+
+// Count returns the number of occurrences of the value, or 0 if it is absent.
+//
+// example usage:
+//
+//	NewMultiSet([]int{1,2,2,3,2}).Count(2)
+//
+// output:
+//
+//	3
+func (m *MultiSet[T]) Count(v T) int {
+	return m.counts[v]
+}
+
+// Distinct returns a new Set holding the distinct values of the multiset.
+func (m *MultiSet[T]) Distinct() *Set[T] {
+	return NewSet(slices.Collect(maps.Keys(m.counts)))
+}
+
+// DistinctLength returns the number of distinct values in the multiset.
+func (m *MultiSet[T]) DistinctLength() int {
+	return len(m.counts)
+}
+
+// Equals returns true if the two multisets hold the same values with the same counts.
+func (m *MultiSet[T]) Equals(m2 *MultiSet[T]) bool {
+	return m.size == m2.size && maps.Equal(m.counts, m2.counts)
+}
+
+// Intersection returns a new multiset holding the values present in both multisets,
+// each with the smaller of its two counts.
+//
+// example usage:
+//
+//	m1 := NewMultiSet([]string{"a","a","a","b"})
+//	m2 := NewMultiSet([]string{"a","a","c"})
+//	m1.Intersection(m2)
+//
+// output:
+//
+//	MultiSet(string) [a a]
+func (m *MultiSet[T]) Intersection(m2 *MultiSet[T]) *MultiSet[T] {
+	small, large := m, m2
+	if len(small.counts) > len(large.counts) {
+		small, large = large, small
+	}
+	r := NewMultiSet[T]()
+	for v, n := range small.counts {
+		r.AddN(v, min(n, large.counts[v]))
+	}
+	return r
+}
+
+// IsEmpty returns true if the multiset holds no values.
+func (m *MultiSet[T]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// MostCommon returns the n values with the highest counts, in descending order of count,
+// or all the values if n is negative. Values with equal counts are in arbitrary order.
+//
+// example usage:
+//
+//	NewMultiSet([]string{"a","b","a","c","a","b"}).MostCommon(2)
+//
+// output:
+//
+//	[{a 3} {b 2}]
+func (m *MultiSet[T]) MostCommon(n int) []collection.Frequency[T] {
+	freqs := make([]collection.Frequency[T], 0, len(m.counts))
+	for v, c := range m.All() {
+		freqs = append(freqs, collection.Frequency[T]{Value: v, Count: c})
+	}
+	slices.SortStableFunc(freqs, func(a, b collection.Frequency[T]) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+	if n >= 0 && n < len(freqs) {
+		freqs = freqs[:n]
+	}
+	return freqs
+}
+
+// RemoveN removes up to n occurrences of the value
+// and returns the number of occurrences removed.
+func (m *MultiSet[T]) RemoveN(v T, n int) int {
+	count := m.counts[v]
+	removed := min(max(n, 0), count)
+	if removed == count {
+		delete(m.counts, v)
+	} else {
+		m.counts[v] = count - removed
+	}
+	m.size -= removed
+	return removed
+}
+
+// Sum returns a new multiset holding the values of both multisets,
+// each with the sum of its two counts.
+func (m *MultiSet[T]) Sum(m2 *MultiSet[T]) *MultiSet[T] {
+	r := m.Clone()
+	for v, n := range m2.counts {
+		r.AddN(v, n)
+	}
+	return r
+}
+
+// ToMap returns a new map from each distinct value to its count.
+func (m *MultiSet[T]) ToMap() map[T]int {
+	return maps.Clone(m.counts)
+}
+
+// Union returns a new multiset holding the values of both multisets,
+// each with the larger of its two counts.
+//
+// example usage:
+//
+//	m1 := NewMultiSet([]string{"a","a","b"})
+//	m2 := NewMultiSet([]string{"a","c"})
+//	m1.Union(m2)
+//
+// output:
+//
+//	MultiSet(string) [a a b c]
+func (m *MultiSet[T]) Union(m2 *MultiSet[T]) *MultiSet[T] {
+	r := m.Clone()
+	for v, n := range m2.counts {
+		r.AddN(v, n-r.counts[v])
+	}
+	return r
+}
+
+// implement the Stringer interface
+func (m *MultiSet[T]) String() string {
+	return fmt.Sprintf("MultiSet(%T) %v", *new(T), collection.AppendToSlice(m, nil))
+}
+
+// distinct returns the distinct values of the multiset,
+// in sorted order if deterministic iteration is enabled.
+func (m *MultiSet[T]) distinct() []T {
+	values := slices.Collect(maps.Keys(m.counts))
+	if deterministic.Load() {
+		slices.SortFunc(values, func(a, b T) int { return compareValues(a, b) })
+	}
+	return values
+}
@@ -0,0 +1,87 @@
+package set
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/charbz/gophers/collection"
+)
+
+func TestMultiSet_ImplementsMutableCollection(t *testing.T) {
+	var _ collection.MutableCollection[int] = NewMultiSet[int]()
+}
+
+func TestMultiSet_Counts(t *testing.T) {
+	m := NewMultiSet([]string{"a", "b", "a"}, []string{"c", "a"})
+	m.AddN("b", 2).AddN("z", 0)
+	if m.Length() != 7 || m.DistinctLength() != 3 {
+		t.Errorf("Length() = %v, DistinctLength() = %v, want %v, %v", m.Length(), m.DistinctLength(), 7, 3)
+	}
+	if m.Count("a") != 3 || m.Count("b") != 3 || m.Count("z") != 0 {
+		t.Errorf("Count() = %v %v %v, want %v %v %v", m.Count("a"), m.Count("b"), m.Count("z"), 3, 3, 0)
+	}
+	if !m.Remove("c") || m.Remove("c") || m.Contains("c") {
+		t.Errorf("Remove() did not remove the only occurrence")
+	}
+	if n := m.RemoveN("a", 2); n != 2 || m.Count("a") != 1 {
+		t.Errorf("RemoveN() = %v leaving %v, want %v leaving %v", n, m.Count("a"), 2, 1)
+	}
+	if n := m.RemoveN("b", 10); n != 3 || m.Contains("b") {
+		t.Errorf("RemoveN() = %v, want %v", n, 3)
+	}
+	if want := map[string]int{"a": 1}; !maps.Equal(m.ToMap(), want) || m.Length() != 1 {
+		t.Errorf("ToMap() = %v, want %v", m.ToMap(), want)
+	}
+	if n := m.RemoveWhere(func(s string) bool { return s == "a" }); n != 1 || !m.IsEmpty() {
+		t.Errorf("RemoveWhere() = %v, want %v", n, 1)
+	}
+}
+
+func TestMultiSet_SetOperations(t *testing.T) {
+	m1 := NewMultiSet([]string{"a", "a", "a", "b"})
+	m2 := NewMultiSet([]string{"a", "a", "c"})
+	tests := []struct {
+		name string
+		got  *MultiSet[string]
+		want map[string]int
+	}{
+		{name: "Union", got: m1.Union(m2), want: map[string]int{"a": 3, "b": 1, "c": 1}},
+		{name: "Intersection", got: m1.Intersection(m2), want: map[string]int{"a": 2}},
+		{name: "Sum", got: m1.Sum(m2), want: map[string]int{"a": 5, "b": 1, "c": 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equals(FromCounts(tt.want)) {
+				t.Errorf("%s() = %v, want %v", tt.name, tt.got.ToMap(), tt.want)
+			}
+		})
+	}
+	if m1.Count("a") != 3 || m2.Length() != 3 {
+		t.Errorf("set operations modified their operands")
+	}
+}
+
+func TestMultiSet_Iteration(t *testing.T) {
+	t.Cleanup(WithDeterministicIteration())
+	m := NewMultiSet([]int{3, 1, 3, 2, 3, 1})
+	if got := slices.Collect(m.Values()); !slices.Equal(got, []int{1, 1, 2, 3, 3, 3}) {
+		t.Errorf("Values() = %v, want %v", got, []int{1, 1, 2, 3, 3, 3})
+	}
+	if got := m.String(); got != "MultiSet(int) [1 1 2 3 3 3]" {
+		t.Errorf("String() = %v, want %v", got, "MultiSet(int) [1 1 2 3 3 3]")
+	}
+	want := []collection.Frequency[int]{{Value: 3, Count: 3}, {Value: 1, Count: 2}}
+	if got := m.MostCommon(2); !slices.Equal(got, want) {
+		t.Errorf("MostCommon() = %v, want %v", got, want)
+	}
+	if got := m.Distinct(); !got.Equals(NewSet([]int{1, 2, 3})) {
+		t.Errorf("Distinct() = %v, want %v", got, []int{1, 2, 3})
+	}
+	if v, err := m.Choice(); err != nil || !m.Contains(v) {
+		t.Errorf("Choice() = %v, %v, want a value of the multiset", v, err)
+	}
+	if got := collection.Frequencies[int](m); !maps.Equal(got, m.ToMap()) {
+		t.Errorf("Frequencies() = %v, want %v", got, m.ToMap())
+	}
+}
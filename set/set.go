@@ -14,36 +14,103 @@ import (
 	"fmt"
 	"iter"
 	"maps"
+	"slices"
 
 	"github.com/charbz/gophers/collection"
 )
 
 type Set[T comparable] struct {
-	elements map[T]struct{}
+	elements  map[T]struct{}
+	normalize func(T) T
 }
 
 func NewSet[T comparable](s ...[]T) *Set[T] {
-	set := new(Set[T])
-	set.elements = make(map[T]struct{})
+	return NewNormalizedSet(nil, s...)
+}
+
+// NewNormalizedSet is a constructor for a set whose values are normalized
+// before being stored or looked up, for example to implement case-insensitive
+// membership checks. The set stores the normalized form of its values.
+// A nil normalize function results in a regular set.
+//
+// example usage:
+//
+//	s := NewNormalizedSet(strings.ToLower, []string{"Apple", "APPLE", "banana"})
+//	s.Contains("BANANA")
+//
+// output:
+//
+//	true
+func NewNormalizedSet[T comparable](normalize func(T) T, s ...[]T) *Set[T] {
+	set := &Set[T]{
+		elements:  make(map[T]struct{}),
+		normalize: normalize,
+	}
 	for _, slice := range s {
 		for _, v := range slice {
-			set.elements[v] = struct{}{}
+			set.elements[set.key(v)] = struct{}{}
 		}
 	}
 	return set
 }
 
+// key returns the normalized form of a value.
+func (s *Set[T]) key(v T) T {
+	if s.normalize == nil {
+		return v
+	}
+	return s.normalize(v)
+}
+
+// NewSetCounting is a constructor for a set holding the values of the given slices,
+// which also returns the number of duplicate values that were dropped.
+//
+// example usage:
+//
+//	s, duplicates := NewSetCounting([]string{"a","b","a"}, []string{"b","c"})
+//	log.Printf("ingested %d values, dropped %d duplicates", s.Length(), duplicates)
+//
+// output:
+//
+//	ingested 3 values, dropped 2 duplicates
+func NewSetCounting[T comparable](s ...[]T) (*Set[T], int) {
+	set := NewSet(s...)
+	total := 0
+	for _, slice := range s {
+		total += len(slice)
+	}
+	return set, total - set.Length()
+}
+
+// NewSetOf is a constructor for a set holding the given values.
+func NewSetOf[T comparable](v ...T) *Set[T] {
+	return NewSet(v)
+}
+
+// FromSeq returns a new set holding the values yielded by the iterator.
+func FromSeq[T comparable](seq iter.Seq[T]) *Set[T] {
+	set := NewSet[T]()
+	for v := range seq {
+		set.Add(v)
+	}
+	return set
+}
+
 // The following methods implement
 // the Collection interface.
 
 func (s *Set[T]) Add(v T) {
-	s.elements[v] = struct{}{}
+	s.elements[s.key(v)] = struct{}{}
 }
 
 func (s *Set[T]) Length() int {
 	return len(s.elements)
 }
 
+// Random returns an arbitrary element from the set.
+//
+// Deprecated: Random panics when the set is empty. Use Choice instead,
+// which returns an EmptyCollectionError for every collection type.
 func (s *Set[T]) Random() T {
 	for v := range s.elements {
 		return v
@@ -51,26 +118,104 @@ func (s *Set[T]) Random() T {
 	panic(collection.EmptyCollectionError)
 }
 
+// Choice is an alias for collection.Choice
+func (s *Set[T]) Choice() (T, error) {
+	return collection.Choice(s)
+}
+
 func (s *Set[T]) New(s2 ...[]T) collection.Collection[T] {
-	return NewSet(s2...)
+	return NewNormalizedSet(s.normalize, s2...)
 }
 
 func (s *Set[T]) Values() iter.Seq[T] {
-	return func(yield func(T) bool) {
-		for k := range s.elements {
-			if !yield(k) {
-				break
+	return s.keys()
+}
+
+// All returns an iterator over index/value pairs of the set, where indices count
+// the elements yielded so far, from 0 to Length()-1. Sets are unordered, so an index
+// only identifies an element within a single iteration: the same element may have a
+// different index in the next one, unless WithDeterministicIteration is in effect.
+// It is meant for code that only needs a position, such as progress reporting.
+//
+// example usage:
+//
+//	s := NewSet([]string{"a","b","c"})
+//	for i, v := range s.All() {
+//	  fmt.Printf("%d/%d %v\n", i+1, s.Length(), v)
+//	}
+//
+// possible output:
+//
+//	1/3 b
+//	2/3 a
+//	3/3 c
+func (s *Set[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for v := range s.keys() {
+			if !yield(i, v) {
+				return
 			}
+			i++
 		}
 	}
 }
 
-func (s *Set[T]) ToSlice() []T {
-	slice := make([]T, 0, len(s.elements))
-	for v := range s.elements {
-		slice = append(slice, v)
+// The following methods implement
+// the MutableCollection interface.
+
+// AddAll adds all the given values to the set.
+func (s *Set[T]) AddAll(v ...T) {
+	for _, value := range v {
+		s.elements[s.key(value)] = struct{}{}
 	}
-	return slice
+}
+
+// Clear removes all elements from the set.
+func (s *Set[T]) Clear() {
+	clear(s.elements)
+}
+
+// Remove removes a value from the set and returns true if the value was present.
+func (s *Set[T]) Remove(v T) bool {
+	n := len(s.elements)
+	delete(s.elements, s.key(v))
+	return len(s.elements) < n
+}
+
+// RemoveWhere removes all elements that satisfy the predicate from the set
+// and returns the number of elements removed.
+func (s *Set[T]) RemoveWhere(f func(T) bool) int {
+	n := len(s.elements)
+	maps.DeleteFunc(s.elements, func(k T, _ struct{}) bool { return f(k) })
+	return n - len(s.elements)
+}
+
+func (s *Set[T]) ToSlice() []T {
+	return s.AppendToSlice(make([]T, 0, len(s.elements)))
+}
+
+// ToSortedSliceFunc returns the elements of the set as a slice sorted according
+// to the less function. Unlike ToSlice, the result does not depend on the iteration
+// order of the set as long as less is a strict total order on its elements, which
+// makes it suitable to snapshot, log or hash sets of types that are not cmp.Ordered.
+func (s *Set[T]) ToSortedSliceFunc(less func(T, T) bool) []T {
+	result := s.ToSlice()
+	slices.SortFunc(result, func(a, b T) int {
+		if less(a, b) {
+			return -1
+		}
+		if less(b, a) {
+			return 1
+		}
+		return 0
+	})
+	return result
+}
+
+// AppendToSlice is an alias for collection.AppendToSlice
+func (s *Set[T]) AppendToSlice(dst []T) []T {
+	return collection.AppendToSlice(s, dst)
 }
 
 // implement the Stringer interface
@@ -82,6 +227,25 @@ func (s *Set[T]) String() string {
 // wrapping Collection functions to enable function chaining:
 // i.e. set.Filter(f).Foreach(f2)
 
+// AddIfAbsent adds a value to the set if it is not already present
+// and returns true if the value was added.
+func (s *Set[T]) AddIfAbsent(v T) bool {
+	k := s.key(v)
+	if _, ok := s.elements[k]; ok {
+		return false
+	}
+	s.elements[k] = struct{}{}
+	return true
+}
+
+// AddSlice adds all the values of a slice to the set.
+func (s *Set[T]) AddSlice(values []T) *Set[T] {
+	for _, v := range values {
+		s.elements[s.key(v)] = struct{}{}
+	}
+	return s
+}
+
 // Apply applies a function to each element in the set.
 func (s *Set[T]) Apply(f func(T) T) *Set[T] {
 	for k := range s.elements {
@@ -95,20 +259,83 @@ func (s *Set[T]) Apply(f func(T) T) *Set[T] {
 // Clone returns a copy of the collection. This is a shallow clone.
 func (s *Set[T]) Clone() *Set[T] {
 	return &Set[T]{
-		elements: maps.Clone(s.elements),
+		elements:  maps.Clone(s.elements),
+		normalize: s.normalize,
 	}
 }
 
+// Snapshot is a copy of the elements of a set taken by Set.Snapshot,
+// which can be restored any number of times with Set.RestoreFrom.
+type Snapshot[T comparable] struct {
+	elements map[T]struct{}
+}
+
+// Snapshot returns a copy of the elements of the set, allowing speculative algorithms
+// such as backtracking searches to revert the set to its current state with RestoreFrom.
+// Mutating the set does not affect the snapshot.
+//
+// example usage:
+//
+//	s := NewSet([]int{1,2,3})
+//	snap := s.Snapshot()
+//	s.Remove(2)
+//	s.RestoreFrom(snap)
+//
+// output:
+//
+//	Set(int) [1 2 3]
+func (s *Set[T]) Snapshot() Snapshot[T] {
+	return Snapshot[T]{elements: maps.Clone(s.elements)}
+}
+
+// RestoreFrom replaces the elements of the set with the elements of the snapshot.
+// It reuses the underlying map of the set, and leaves the snapshot unchanged.
+// Elements are restored as they were stored, without applying the normalization
+// function of the set.
+func (s *Set[T]) RestoreFrom(snap Snapshot[T]) *Set[T] {
+	clear(s.elements)
+	maps.Copy(s.elements, snap.elements)
+	return s
+}
+
 func (s *Set[T]) Count(f func(T) bool) int {
 	return collection.Count(s, f)
 }
 
+// CountValue returns 1 if the set contains the value and 0 otherwise.
+func (s *Set[T]) CountValue(v T) int {
+	if s.Contains(v) {
+		return 1
+	}
+	return 0
+}
+
 // Contains returns true if the set contains the value.
 func (s *Set[T]) Contains(v T) bool {
-	_, ok := s.elements[v]
+	_, ok := s.elements[s.key(v)]
 	return ok
 }
 
+// ContainsAll returns true if the set contains every value of the slice.
+func (s *Set[T]) ContainsAll(values []T) bool {
+	for _, v := range values {
+		if !s.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAny returns true if the set contains at least one value of the slice.
+func (s *Set[T]) ContainsAny(values []T) bool {
+	for _, v := range values {
+		if s.Contains(v) {
+			return true
+		}
+	}
+	return false
+}
+
 // ContainsFunc returns true if the set contains a value that satisfies the predicate.
 func (s *Set[T]) ContainsFunc(f func(T) bool) bool {
 	for v := range s.Values() {
@@ -123,7 +350,7 @@ func (s *Set[T]) ContainsFunc(f func(T) bool) bool {
 func (s *Set[T]) Diff(set *Set[T]) *Set[T] {
 	newSet := s.Clone()
 	for k := range set.Values() {
-		delete(newSet.elements, k)
+		newSet.Remove(k)
 	}
 	return newSet
 }
@@ -131,9 +358,9 @@ func (s *Set[T]) Diff(set *Set[T]) *Set[T] {
 // DiffIterator returns an iterator over the difference of the current set and the passed in set.
 func (s *Set[T]) DiffIterator(set *Set[T]) iter.Seq[T] {
 	return func(yield func(T) bool) {
-		for k := range s.elements {
-			if !set.Contains(k) {
-				yield(k)
+		for k := range s.keys() {
+			if !set.Contains(k) && !yield(k) {
+				return
 			}
 		}
 	}
@@ -154,7 +381,7 @@ func (s *Set[T]) Equals(s2 *Set[T]) bool {
 
 // Filter is an alias for collection.Filter
 func (s *Set[T]) Filter(f func(T) bool) *Set[T] {
-	return collection.Filter(s, f).(*Set[T])
+	return collection.MustCast[*Set[T]]("Filter", collection.Filter(s, f))
 }
 
 // Filtered is an alias for collection.Filtered
@@ -164,7 +391,22 @@ func (s *Set[T]) Filtered(f func(T) bool) iter.Seq[T] {
 
 // FilterNot is an alias for collection.FilterNot
 func (s *Set[T]) FilterNot(f func(T) bool) *Set[T] {
-	return collection.FilterNot(s, f).(*Set[T])
+	return collection.MustCast[*Set[T]]("FilterNot", collection.FilterNot(s, f))
+}
+
+// FindOr is an alias for collection.FindOr
+func (s *Set[T]) FindOr(f func(T) bool, def T) T {
+	return collection.FindOr(s, f, def)
+}
+
+// Fingerprint is an alias for collection.FingerprintUnordered
+func (s *Set[T]) Fingerprint() uint64 {
+	return collection.FingerprintUnordered(s)
+}
+
+// ForEachWhile is an alias for collection.ForEachWhile
+func (s *Set[T]) ForEachWhile(f func(T) bool) bool {
+	return collection.ForEachWhile(s, f)
 }
 
 // ForAll is an alias for collection.ForAll
@@ -177,11 +419,22 @@ func (s *Set[T]) IsEmpty() bool {
 	return s.Length() == 0
 }
 
+// Freeze returns a read-only view of the set.
+func (s *Set[T]) Freeze() collection.ReadOnlyCollection[T] {
+	return collection.Freeze(s)
+}
+
+// InspectEach is an alias for collection.InspectEach
+func (s *Set[T]) InspectEach(f func(int, T)) *Set[T] {
+	collection.InspectEach(s, f)
+	return s
+}
+
 // Intersection returns a new set containing the intersection of the current set and the passed in set.
 func (s *Set[T]) Intersection(s2 *Set[T]) *Set[T] {
-	result := NewSet[T]()
+	result := NewNormalizedSet[T](s.normalize)
 	for k := range s2.elements {
-		if _, ok := s.elements[k]; ok {
+		if s.Contains(k) {
 			result.Add(k)
 		}
 	}
@@ -192,33 +445,114 @@ func (s *Set[T]) Intersection(s2 *Set[T]) *Set[T] {
 // the current set and the passed in set.
 func (s *Set[T]) Intersected(s2 *Set[T]) iter.Seq[T] {
 	return func(yield func(T) bool) {
-		for k := range s.elements {
-			if s2.Contains(k) {
-				yield(k)
+		for k := range s.keys() {
+			if s2.Contains(k) && !yield(k) {
+				return
 			}
 		}
 	}
 }
 
+// intersectionSize returns the number of elements present in both sets,
+// iterating over the smaller set without materializing the intersection.
+func (s *Set[T]) intersectionSize(s2 *Set[T]) int {
+	small, large := s, s2
+	if small.Length() > large.Length() {
+		small, large = large, small
+	}
+	n := 0
+	for k := range small.elements {
+		if large.Contains(k) {
+			n++
+		}
+	}
+	return n
+}
+
+// Jaccard returns the Jaccard similarity coefficient of the two sets, the size of their
+// intersection divided by the size of their union, ranging from 0 for disjoint sets to 1
+// for equal sets. Two empty sets have a coefficient of 1.
+//
+// example usage:
+//
+//	s1 := NewSet([]string{"go","rust","zig"})
+//	s2 := NewSet([]string{"go","zig","c"})
+//	s1.Jaccard(s2)
+//
+// output:
+//
+//	0.5
+func (s *Set[T]) Jaccard(s2 *Set[T]) float64 {
+	n := s.intersectionSize(s2)
+	union := s.Length() + s2.Length() - n
+	if union == 0 {
+		return 1
+	}
+	return float64(n) / float64(union)
+}
+
+// None is an alias for collection.None
+func (s *Set[T]) None(f func(T) bool) bool {
+	return collection.None(s, f)
+}
+
 // NonEmpty returns true if the set is not empty.
 func (s *Set[T]) NonEmpty() bool {
 	return s.Length() > 0
 }
 
+// OverlapCoefficient returns the overlap coefficient of the two sets, the size of their
+// intersection divided by the size of the smaller set. It is 1 when one set is a subset
+// of the other, which makes it a tolerant alternative to Equals, and 0 for disjoint sets.
+// Two empty sets have a coefficient of 1, while an empty and a non-empty set have 0.
+//
+// example usage:
+//
+//	s1 := NewSet([]string{"go","zig"})
+//	s2 := NewSet([]string{"go","zig","c"})
+//	s1.OverlapCoefficient(s2)
+//
+// output:
+//
+//	1
+func (s *Set[T]) OverlapCoefficient(s2 *Set[T]) float64 {
+	smaller := min(s.Length(), s2.Length())
+	if smaller == 0 {
+		if s.Length() == s2.Length() {
+			return 1
+		}
+		return 0
+	}
+	return float64(s.intersectionSize(s2)) / float64(smaller)
+}
+
 // Partition is an alias for collection.Partition
 func (s *Set[T]) Partition(f func(T) bool) (*Set[T], *Set[T]) {
 	left, right := collection.Partition(s, f)
-	return left.(*Set[T]), right.(*Set[T])
+	return collection.MustCast[*Set[T]]("Partition", left), collection.MustCast[*Set[T]]("Partition", right)
 }
 
-// Remove removes a value from the set.
-func (s *Set[T]) Remove(v T) {
-	delete(s.elements, v)
+// Shard is an alias for collection.Shard
+func (s *Set[T]) Shard(n int) []*Set[T] {
+	shards := collection.Shard(s, n)
+	result := make([]*Set[T], len(shards))
+	for i, shard := range shards {
+		result[i] = collection.MustCast[*Set[T]]("Shard", shard)
+	}
+	return result
+}
+
+// RemoveSlice removes all the values of a slice from the set.
+func (s *Set[T]) RemoveSlice(values []T) *Set[T] {
+	for _, v := range values {
+		delete(s.elements, s.key(v))
+	}
+	return s
 }
 
 // Reject is an alias for collection.FilterNot
 func (l *Set[T]) Reject(f func(T) bool) *Set[T] {
-	return collection.FilterNot(l, f).(*Set[T])
+	return collection.MustCast[*Set[T]]("Reject", collection.FilterNot(l, f))
 }
 
 // Rejected is an alias for collection.Rejected
@@ -226,6 +560,12 @@ func (s *Set[T]) Rejected(f func(T) bool) iter.Seq[T] {
 	return collection.Rejected(s, f)
 }
 
+// Tap is an alias for collection.Tap
+func (s *Set[T]) Tap(f func(collection.Collection[T])) *Set[T] {
+	collection.Tap(s, f)
+	return s
+}
+
 // Union returns a new set containing the union of the current set and the passed in set.
 func (s *Set[T]) Union(s2 *Set[T]) *Set[T] {
 	result := s.Clone()
@@ -238,12 +578,14 @@ func (s *Set[T]) Union(s2 *Set[T]) *Set[T] {
 // Unioned returns an iterator over the union of the current set and the passed in set.
 func (s *Set[T]) Unioned(s2 *Set[T]) iter.Seq[T] {
 	return func(yield func(T) bool) {
-		for k := range s.elements {
-			yield(k)
+		for k := range s.keys() {
+			if !yield(k) {
+				return
+			}
 		}
-		for k := range s2.elements {
-			if !s.Contains(k) {
-				yield(k)
+		for k := range s2.keys() {
+			if !s.Contains(k) && !yield(k) {
+				return
 			}
 		}
 	}
@@ -0,0 +1,160 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package set
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/charbz/gophers/collection"
+)
+
+// SetBy is a set of values that are unique by a key derived from each value,
+// which allows sets of non-comparable types, or of values identified by one of their fields.
+// When a value is added, it replaces any value with the same key.
+type SetBy[T any, K comparable] struct {
+	elements map[K]T
+	key      func(T) K
+}
+
+// NewSetBy is a constructor for a set of values that are unique by the key returned by f.
+//
+// example usage:
+//
+//	type User struct { ID int; Name string }
+//	s := NewSetBy(func(u User) int { return u.ID }, []User{{1, "ann"}, {2, "bob"}, {1, "ann b."}})
+//	s.Length()
+//
+// output:
+//
+//	2
+func NewSetBy[T any, K comparable](f func(T) K, s ...[]T) *SetBy[T, K] {
+	set := &SetBy[T, K]{
+		elements: make(map[K]T),
+		key:      f,
+	}
+	for _, slice := range s {
+		for _, v := range slice {
+			set.elements[f(v)] = v
+		}
+	}
+	return set
+}
+
+// The following methods implement
+// the Collection interface.
+
+func (s *SetBy[T, K]) Add(v T) {
+	s.elements[s.key(v)] = v
+}
+
+func (s *SetBy[T, K]) Length() int {
+	return len(s.elements)
+}
+
+// Random returns an arbitrary element from the set.
+//
+// Deprecated: Random panics when the set is empty. Use Choice instead,
+// which returns an EmptyCollectionError for every collection type.
+func (s *SetBy[T, K]) Random() T {
+	for _, v := range s.elements {
+		return v
+	}
+	panic(collection.EmptyCollectionError)
+}
+
+// Choice is an alias for collection.Choice
+func (s *SetBy[T, K]) Choice() (T, error) {
+	return collection.Choice(s)
+}
+
+func (s *SetBy[T, K]) New(s2 ...[]T) collection.Collection[T] {
+	return NewSetBy(s.key, s2...)
+}
+
+func (s *SetBy[T, K]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.elements {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// The following methods are specific to the SetBy type.
+
+// AddIfAbsent adds a value to the set if no value with the same key is present
+// and returns true if the value was added.
+func (s *SetBy[T, K]) AddIfAbsent(v T) bool {
+	k := s.key(v)
+	if _, ok := s.elements[k]; ok {
+		return false
+	}
+	s.elements[k] = v
+	return true
+}
+
+// Contains returns true if the set contains a value with the same key as v.
+func (s *SetBy[T, K]) Contains(v T) bool {
+	_, ok := s.elements[s.key(v)]
+	return ok
+}
+
+// Get returns the value stored for the given key and true,
+// or the zero value and false if there is none.
+func (s *SetBy[T, K]) Get(key K) (T, bool) {
+	v, ok := s.elements[key]
+	return v, ok
+}
+
+// GetOrAdd returns the value stored for the given key. If there is none, it calls
+// the supplier, adds the value it returns to the set and returns it.
+// It panics with an InvalidArgumentError if the supplied value does not have the given key.
+//
+// example usage:
+//
+//	users := NewSetBy(func(u User) int { return u.ID })
+//	users.GetOrAdd(1, func() User { return loadUser(1) })
+//
+// output:
+//
+//	{1 ann}
+func (s *SetBy[T, K]) GetOrAdd(key K, supplier func() T) T {
+	if v, ok := s.elements[key]; ok {
+		return v
+	}
+	v := supplier()
+	if s.key(v) != key {
+		panic(collection.InvalidArgumentError)
+	}
+	s.elements[key] = v
+	return v
+}
+
+// Remove removes the value with the same key as v
+// and returns true if such a value was present.
+func (s *SetBy[T, K]) Remove(v T) bool {
+	k := s.key(v)
+	if _, ok := s.elements[k]; !ok {
+		return false
+	}
+	delete(s.elements, k)
+	return true
+}
+
+// ToSlice returns a slice containing all values in the set.
+func (s *SetBy[T, K]) ToSlice() []T {
+	slice := make([]T, 0, len(s.elements))
+	for _, v := range s.elements {
+		slice = append(slice, v)
+	}
+	return slice
+}
+
+// implement the Stringer interface
+func (s *SetBy[T, K]) String() string {
+	return fmt.Sprintf("SetBy(%T) %v", *new(T), s.ToSlice())
+}
@@ -0,0 +1,70 @@
+package set
+
+import (
+	"testing"
+
+	"github.com/charbz/gophers/collection"
+)
+
+type user struct {
+	id   int
+	tags []string
+}
+
+func TestSetBy_ImplementsCollection(t *testing.T) {
+	var _ collection.Collection[user] = NewSetBy(func(u user) int { return u.id })
+}
+
+func TestSetBy_Add(t *testing.T) {
+	s := NewSetBy(func(u user) int { return u.id }, []user{{id: 1}, {id: 2}})
+	s.Add(user{id: 1, tags: []string{"admin"}})
+	if s.Length() != 2 {
+		t.Errorf("Length() = %v, want %v", s.Length(), 2)
+	}
+	if v, _ := s.Get(1); len(v.tags) != 1 {
+		t.Errorf("Add() did not replace the value with the same key, got %v", v)
+	}
+	if !s.Contains(user{id: 2}) || s.Contains(user{id: 3}) {
+		t.Errorf("Contains() returned unexpected results")
+	}
+	if !s.Remove(user{id: 2}) || s.Remove(user{id: 2}) {
+		t.Errorf("Remove() returned unexpected results")
+	}
+}
+
+func TestSetBy_AddIfAbsent(t *testing.T) {
+	s := NewSetBy(func(u user) int { return u.id })
+	if !s.AddIfAbsent(user{id: 1}) {
+		t.Errorf("AddIfAbsent() = %v, want %v", false, true)
+	}
+	if s.AddIfAbsent(user{id: 1, tags: []string{"admin"}}) {
+		t.Errorf("AddIfAbsent() = %v, want %v", true, false)
+	}
+	if v, _ := s.Get(1); v.tags != nil {
+		t.Errorf("AddIfAbsent() replaced an existing value, got %v", v)
+	}
+}
+
+func TestSetBy_GetOrAdd(t *testing.T) {
+	s := NewSetBy(func(u user) int { return u.id })
+	calls := 0
+	supplier := func() user {
+		calls++
+		return user{id: 7, tags: []string{"new"}}
+	}
+	first := s.GetOrAdd(7, supplier)
+	second := s.GetOrAdd(7, supplier)
+	if calls != 1 {
+		t.Errorf("GetOrAdd() called the supplier %v times, want %v", calls, 1)
+	}
+	if first.id != 7 || second.id != 7 || s.Length() != 1 {
+		t.Errorf("GetOrAdd() = %v, %v, want the supplied value", first, second)
+	}
+
+	defer func() {
+		if r := recover(); r != collection.InvalidArgumentError {
+			t.Errorf("GetOrAdd() panic = %v, want %v", r, collection.InvalidArgumentError)
+		}
+	}()
+	s.GetOrAdd(8, supplier)
+}
@@ -2,8 +2,12 @@ package set
 
 import (
 	"cmp"
+	"iter"
 	"slices"
+	"strings"
 	"testing"
+
+	"github.com/charbz/gophers/collection"
 )
 
 func TestSet_Contains(t *testing.T) {
@@ -318,6 +322,40 @@ func TestSet_Equals(t *testing.T) {
 	}
 }
 
+func TestSet_Similarity(t *testing.T) {
+	tests := []struct {
+		name    string
+		s1      []int
+		s2      []int
+		jaccard float64
+		overlap float64
+	}{
+		{name: "equal sets", s1: []int{1, 2, 3}, s2: []int{3, 2, 1}, jaccard: 1, overlap: 1},
+		{name: "partial overlap", s1: []int{1, 2, 3}, s2: []int{2, 3, 4}, jaccard: 0.5, overlap: 2.0 / 3},
+		{name: "subset", s1: []int{1, 2}, s2: []int{1, 2, 3, 4}, jaccard: 0.5, overlap: 1},
+		{name: "disjoint", s1: []int{1, 2}, s2: []int{3}, jaccard: 0, overlap: 0},
+		{name: "one empty", s1: []int{}, s2: []int{1}, jaccard: 0, overlap: 0},
+		{name: "both empty", s1: []int{}, s2: []int{}, jaccard: 1, overlap: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s1, s2 := NewSet(tt.s1), NewSet(tt.s2)
+			if got := s1.Jaccard(s2); got != tt.jaccard {
+				t.Errorf("Jaccard() = %v, want %v", got, tt.jaccard)
+			}
+			if got := s2.Jaccard(s1); got != tt.jaccard {
+				t.Errorf("Jaccard() = %v, want %v when swapped", got, tt.jaccard)
+			}
+			if got := s1.OverlapCoefficient(s2); got != tt.overlap {
+				t.Errorf("OverlapCoefficient() = %v, want %v", got, tt.overlap)
+			}
+			if got := s2.OverlapCoefficient(s1); got != tt.overlap {
+				t.Errorf("OverlapCoefficient() = %v, want %v when swapped", got, tt.overlap)
+			}
+		})
+	}
+}
+
 func TestSet_IsEmpty(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -347,6 +385,20 @@ func TestSet_IsEmpty(t *testing.T) {
 	}
 }
 
+func TestSet_SnapshotRestore(t *testing.T) {
+	s := NewSet([]int{1, 2, 3})
+	snap := s.Snapshot()
+	s.Remove(2)
+	s.Add(4)
+	if got := s.RestoreFrom(snap); !got.Equals(NewSet([]int{1, 2, 3})) {
+		t.Errorf("RestoreFrom() = %v, want %v", got, []int{1, 2, 3})
+	}
+	s.Clear()
+	if got := s.RestoreFrom(snap); !got.Equals(NewSet([]int{1, 2, 3})) {
+		t.Errorf("RestoreFrom() = %v after a second restore, want %v", got, []int{1, 2, 3})
+	}
+}
+
 func TestSet_Clone(t *testing.T) {
 	original := NewSet([]int{1, 2, 3})
 	clone := original.Clone()
@@ -554,6 +606,16 @@ func TestSet_Count(t *testing.T) {
 	}
 }
 
+func TestSet_CountValue(t *testing.T) {
+	s := NewNormalizedSet(strings.ToLower, []string{"Go", "GO", "rust"})
+	if got := s.CountValue("go"); got != 1 {
+		t.Errorf("CountValue() = %v, want %v", got, 1)
+	}
+	if got := s.CountValue("zig"); got != 0 {
+		t.Errorf("CountValue() = %v, want %v", got, 0)
+	}
+}
+
 func TestSet_Random(t *testing.T) {
 	s := NewSet([]int{1})
 	if got := s.Random(); got != 1 {
@@ -561,12 +623,56 @@ func TestSet_Random(t *testing.T) {
 	}
 }
 
+func TestSet_All(t *testing.T) {
+	s := NewSet([]int{1, 2, 3, 4})
+	var indices, values []int
+	for i, v := range s.All() {
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+	if !slices.Equal(indices, []int{0, 1, 2, 3}) || !assertEqualValues(values, []int{1, 2, 3, 4}) {
+		t.Errorf("All() = %v, %v, want %v, %v", indices, values, []int{0, 1, 2, 3}, []int{1, 2, 3, 4})
+	}
+	for i := range s.All() {
+		if i == 1 {
+			break
+		}
+	}
+}
+
+func TestSet_Choice(t *testing.T) {
+	if got, err := NewSet([]int{1}).Choice(); got != 1 || err != nil {
+		t.Errorf("Choice() = %v, %v, want %v, %v", got, err, 1, nil)
+	}
+	if _, err := NewSet[int]().Choice(); err != collection.EmptyCollectionError {
+		t.Errorf("Choice() error = %v, want %v", err, collection.EmptyCollectionError)
+	}
+}
+
 func TestSet_Remove(t *testing.T) {
 	s := NewSet([]int{1, 2, 3})
-	s.Remove(2)
+	if !s.Remove(2) {
+		t.Errorf("Remove() = %v, want %v", false, true)
+	}
 	if !assertEqualValues(s.ToSlice(), []int{1, 3}) {
 		t.Errorf("Remove() = %v, want %v", s.ToSlice(), []int{1, 3})
 	}
+	if s.Remove(2) {
+		t.Errorf("Remove() of a missing value = %v, want %v", true, false)
+	}
+}
+
+func TestSet_RemoveWhere(t *testing.T) {
+	s := NewSet([]int{1, 2, 3, 4, 5})
+	if got := s.RemoveWhere(func(i int) bool { return i > 2 }); got != 3 {
+		t.Errorf("RemoveWhere() = %v, want %v", got, 3)
+	}
+	if got := s.RemoveWhere(func(i int) bool { return i > 2 }); got != 0 {
+		t.Errorf("RemoveWhere() = %v, want %v", got, 0)
+	}
+	if !assertEqualValues(s.ToSlice(), []int{1, 2}) {
+		t.Errorf("RemoveWhere() = %v, want %v", s.ToSlice(), []int{1, 2})
+	}
 }
 
 func TestSet_Reject(t *testing.T) {
@@ -643,3 +749,260 @@ func assertEqualValues[T cmp.Ordered](a []T, b []T) bool {
 	slices.Sort(b)
 	return slices.Equal(a, b)
 }
+
+func TestSet_AddSlice(t *testing.T) {
+	s := NewSet([]int{1, 2})
+	s.AddSlice([]int{2, 3, 4})
+	if !assertEqualValues(s.ToSlice(), []int{1, 2, 3, 4}) {
+		t.Errorf("AddSlice() = %v, want %v", s.ToSlice(), []int{1, 2, 3, 4})
+	}
+}
+
+func TestSet_RemoveSlice(t *testing.T) {
+	s := NewSet([]int{1, 2, 3, 4})
+	s.RemoveSlice([]int{2, 4, 5})
+	if !assertEqualValues(s.ToSlice(), []int{1, 3}) {
+		t.Errorf("RemoveSlice() = %v, want %v", s.ToSlice(), []int{1, 3})
+	}
+}
+
+func TestSet_ContainsAnyAll(t *testing.T) {
+	tests := []struct {
+		name    string
+		slice   []int
+		values  []int
+		wantAny bool
+		wantAll bool
+	}{
+		{name: "all values present", slice: []int{1, 2, 3}, values: []int{1, 3}, wantAny: true, wantAll: true},
+		{name: "some values present", slice: []int{1, 2, 3}, values: []int{3, 4}, wantAny: true, wantAll: false},
+		{name: "no values present", slice: []int{1, 2, 3}, values: []int{4, 5}, wantAny: false, wantAll: false},
+		{name: "empty values", slice: []int{1, 2, 3}, values: []int{}, wantAny: false, wantAll: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSet(tt.slice)
+			if got := s.ContainsAny(tt.values); got != tt.wantAny {
+				t.Errorf("ContainsAny() = %v, want %v", got, tt.wantAny)
+			}
+			if got := s.ContainsAll(tt.values); got != tt.wantAll {
+				t.Errorf("ContainsAll() = %v, want %v", got, tt.wantAll)
+			}
+		})
+	}
+}
+
+func TestSet_ImplementsMutableCollection(t *testing.T) {
+	var c collection.MutableCollection[int] = NewSet([]int{1, 2, 3})
+	c.Remove(2)
+	c.AddAll(4, 5, 6)
+	c.RemoveWhere(func(i int) bool { return i%2 == 0 })
+	if got := c.(*Set[int]).ToSlice(); !assertEqualValues(got, []int{1, 3, 5}) {
+		t.Errorf("RemoveWhere() = %v, want %v", got, []int{1, 3, 5})
+	}
+	c.Clear()
+	if c.Length() != 0 {
+		t.Errorf("Clear() left %v elements, want %v", c.Length(), 0)
+	}
+}
+
+func TestNewSetOf(t *testing.T) {
+	s := NewSetOf(1, 2, 2, 3)
+	if !assertEqualValues(s.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("NewSetOf() = %v, want %v", s.ToSlice(), []int{1, 2, 3})
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	s := FromSeq(slices.Values([]int{3, 1, 3, 2}))
+	if !assertEqualValues(s.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("FromSeq() = %v, want %v", s.ToSlice(), []int{1, 2, 3})
+	}
+}
+
+func TestNewSetCounting(t *testing.T) {
+	s, duplicates := NewSetCounting([]string{"a", "b", "a"}, []string{"b", "c"})
+	if !assertEqualValues(s.ToSlice(), []string{"a", "b", "c"}) || duplicates != 2 {
+		t.Errorf("NewSetCounting() = %v, %v, want %v, %v", s.ToSlice(), duplicates, []string{"a", "b", "c"}, 2)
+	}
+	s, duplicates = NewSetCounting[string]()
+	if s.Length() != 0 || duplicates != 0 {
+		t.Errorf("NewSetCounting() = %v, %v, want %v, %v", s.ToSlice(), duplicates, []string{}, 0)
+	}
+}
+
+func TestNewNormalizedSet(t *testing.T) {
+	s := NewNormalizedSet(strings.ToLower, []string{"Apple", "APPLE", "banana"})
+	if s.Length() != 2 {
+		t.Errorf("Length() = %v, want %v", s.Length(), 2)
+	}
+	if !s.Contains("BANANA") || !s.Contains("apple") || s.Contains("cherry") {
+		t.Errorf("Contains() did not normalize values, set = %v", s)
+	}
+	s.Add("Cherry")
+	s.AddAll("CHERRY", "Date")
+	if !assertEqualValues(s.ToSlice(), []string{"apple", "banana", "cherry", "date"}) {
+		t.Errorf("Add() = %v, want %v", s.ToSlice(), []string{"apple", "banana", "cherry", "date"})
+	}
+	if !s.Remove("DATE") {
+		t.Errorf("Remove() = %v, want %v", false, true)
+	}
+
+	filtered := s.Filter(func(v string) bool { return v != "apple" })
+	if !filtered.Contains("BANANA") {
+		t.Errorf("Filter() did not preserve normalization")
+	}
+	if !s.Clone().Contains("CHERRY") {
+		t.Errorf("Clone() did not preserve normalization")
+	}
+	other := NewSet([]string{"BANANA", "kiwi"})
+	if got := s.Intersection(other).ToSlice(); !assertEqualValues(got, []string{"banana"}) {
+		t.Errorf("Intersection() = %v, want %v", got, []string{"banana"})
+	}
+	if got := s.Diff(other).ToSlice(); !assertEqualValues(got, []string{"apple", "cherry"}) {
+		t.Errorf("Diff() = %v, want %v", got, []string{"apple", "cherry"})
+	}
+}
+
+func TestSet_FindOr(t *testing.T) {
+	s := NewSet([]int{1, 2, 3})
+	if got := s.FindOr(func(i int) bool { return i > 2 }, 0); got != 3 {
+		t.Errorf("FindOr() = %v, want %v", got, 3)
+	}
+	if got := s.FindOr(func(i int) bool { return i > 3 }, 0); got != 0 {
+		t.Errorf("FindOr() = %v, want %v", got, 0)
+	}
+}
+
+func TestSet_Tap(t *testing.T) {
+	var length int
+	var inspected []int
+	NewSet([]int{1, 2, 3}).
+		Tap(func(c collection.Collection[int]) { length = c.Length() }).
+		InspectEach(func(_ int, v int) { inspected = append(inspected, v) })
+	if length != 3 || !assertEqualValues(inspected, []int{1, 2, 3}) {
+		t.Errorf("Tap() = %v, InspectEach() = %v, want %v, %v", length, inspected, 3, []int{1, 2, 3})
+	}
+}
+
+func TestSet_Freeze(t *testing.T) {
+	s := NewSet([]int{1, 2})
+	v := s.Freeze()
+	if _, ok := v.(*Set[int]); ok {
+		t.Errorf("Freeze() exposed the underlying set")
+	}
+	s.Add(3)
+	if v.Length() != 3 {
+		t.Errorf("Freeze() view did not reflect changes to the set")
+	}
+}
+
+func TestSet_IteratorsBreakEarly(t *testing.T) {
+	a := NewSet([]int{1, 2, 3, 4, 5, 6})
+	b := NewSet([]int{2, 4, 6, 8, 10})
+	tests := []struct {
+		name string
+		seq  iter.Seq[int]
+		want int
+	}{
+		{name: "DiffIterator", seq: a.DiffIterator(b), want: 2},
+		{name: "Intersected", seq: a.Intersected(b), want: 2},
+		{name: "Unioned", seq: a.Unioned(b), want: 2},
+		{name: "Unioned second set", seq: NewSet([]int{}).Unioned(b), want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count := 0
+			for range tt.seq {
+				count++
+				if count == tt.want {
+					break
+				}
+			}
+			if count != tt.want {
+				t.Errorf("%s() yielded %v values, want %v", tt.name, count, tt.want)
+			}
+		})
+	}
+}
+
+func TestSet_AppendToSlice(t *testing.T) {
+	s := NewSet([]int{2, 3})
+	got := s.AppendToSlice([]int{1})
+	if got[0] != 1 || !assertEqualValues(got[1:], []int{2, 3}) {
+		t.Errorf("AppendToSlice() = %v, want %v", got, []int{1, 2, 3})
+	}
+}
+
+func TestSet_AddIfAbsent(t *testing.T) {
+	s := NewNormalizedSet(strings.ToLower, []string{"go"})
+	if s.AddIfAbsent("GO") {
+		t.Errorf("AddIfAbsent() = %v, want %v", true, false)
+	}
+	if !s.AddIfAbsent("zig") {
+		t.Errorf("AddIfAbsent() = %v, want %v", false, true)
+	}
+	if s.Length() != 2 {
+		t.Errorf("Length() = %v, want %v", s.Length(), 2)
+	}
+}
+
+func TestSet_Shard(t *testing.T) {
+	s := NewSet([]int{1, 2, 3, 4, 5})
+	shards := s.Shard(2)
+	var all []int
+	for _, shard := range shards {
+		if shard.Length() < 2 || shard.Length() > 3 {
+			t.Errorf("Shard() length = %v, want 2 or 3", shard.Length())
+		}
+		all = append(all, shard.ToSlice()...)
+	}
+	if !assertEqualValues(all, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("Shard() = %v, want %v", all, []int{1, 2, 3, 4, 5})
+	}
+}
+
+func TestSet_Fingerprint(t *testing.T) {
+	seen := make(map[uint64]*Set[string])
+	for _, s := range []*Set[string]{
+		NewSet([]string{"a", "b", "c"}),
+		NewSet([]string{"c", "a", "b", "a"}),
+		NewSet([]string{"a", "b"}),
+	} {
+		seen[s.Fingerprint()] = s
+	}
+	if len(seen) != 2 {
+		t.Errorf("Fingerprint() produced %v distinct keys, want %v", len(seen), 2)
+	}
+}
+
+func TestSet_ToSortedSliceFunc(t *testing.T) {
+	type point struct{ x, y int }
+	s := NewSet([]point{{2, 1}, {1, 2}, {1, 1}, {2, 0}})
+	want := []point{{1, 1}, {1, 2}, {2, 0}, {2, 1}}
+	less := func(a, b point) bool { return a.x < b.x || (a.x == b.x && a.y < b.y) }
+	for range 5 {
+		if got := s.ToSortedSliceFunc(less); !slices.Equal(got, want) {
+			t.Errorf("ToSortedSliceFunc() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestSet_Accumulated(t *testing.T) {
+	sum := collection.NewAccumulated[int](NewSet([]int{1, 2, 3}), collection.NewAccumulator(0,
+		func(acc, v int) int { return acc + v },
+		func(acc, v int) int { return acc - v },
+		nil,
+	))
+	// values already in the set are not accumulated again.
+	sum.Add(3)
+	sum.AddAll(1, 2)
+	if got := sum.Value(); got != 6 {
+		t.Errorf("Value() = %v, want %v", got, 6)
+	}
+	sum.AddAll(2, 4, 4)
+	if got := sum.Value(); got != 10 {
+		t.Errorf("Value() = %v, want %v", got, 10)
+	}
+}
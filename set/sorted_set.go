@@ -0,0 +1,234 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package set
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math/rand"
+
+	"github.com/charbz/gophers/collection"
+	"github.com/charbz/gophers/treemap"
+)
+
+// SortedSet is a set of ordered values which iterates over its values in ascending order.
+// It is backed by a balanced binary search tree, a treemap.Map with empty values, so that
+// insertions, removals and lookups run in O(log n) time and the values can be queried by
+// range or by their nearest neighbor.
+type SortedSet[T cmp.Ordered] struct {
+	elements *treemap.Map[T, struct{}]
+}
+
+// NewSortedSet is a constructor for a sorted set holding the values of the given slices.
+//
+// example usage:
+//
+//	NewSortedSet([]int{3,1,2,1})
+//
+// output:
+//
+//	SortedSet(int) [1 2 3]
+func NewSortedSet[T cmp.Ordered](s ...[]T) *SortedSet[T] {
+	set := &SortedSet[T]{elements: treemap.New[T, struct{}]()}
+	for _, slice := range s {
+		set.AddAll(slice...)
+	}
+	return set
+}
+
+// The following methods implement
+// the Collection interface.
+
+// Add adds a value to the set.
+func (s *SortedSet[T]) Add(v T) {
+	s.elements.Put(v, struct{}{})
+}
+
+// Length returns the number of values in the set.
+func (s *SortedSet[T]) Length() int {
+	return s.elements.Length()
+}
+
+// New returns a new sorted set holding the values of the given slices.
+func (s *SortedSet[T]) New(s2 ...[]T) collection.Collection[T] {
+	return NewSortedSet(s2...)
+}
+
+// Random returns a random value from the set.
+// It panics with an EmptyCollectionError if the set is empty,
+// use Choice to get an error instead.
+func (s *SortedSet[T]) Random() T {
+	if s.IsEmpty() {
+		panic(collection.EmptyCollectionError)
+	}
+	i := rand.Intn(s.Length())
+	for v := range s.Values() {
+		if i == 0 {
+			return v
+		}
+		i--
+	}
+	panic(collection.EmptyCollectionError)
+}
+
+// Choice is an alias for collection.Choice
+func (s *SortedSet[T]) Choice() (T, error) {
+	return collection.Choice(s)
+}
+
+// Values returns an iterator over the values of the set in ascending order.
+func (s *SortedSet[T]) Values() iter.Seq[T] {
+	return s.elements.Keys()
+}
+
+// The following methods implement
+// the MutableCollection interface.
+
+// AddAll adds all the given values to the set.
+func (s *SortedSet[T]) AddAll(v ...T) {
+	for _, e := range v {
+		s.Add(e)
+	}
+}
+
+// Clear removes all values from the set.
+func (s *SortedSet[T]) Clear() {
+	s.elements.Clear()
+}
+
+// Remove removes a value from the set and returns true if it was present.
+func (s *SortedSet[T]) Remove(v T) bool {
+	return s.elements.Remove(v)
+}
+
+// RemoveWhere removes all the values that satisfy the predicate
+// and returns the number of values removed.
+func (s *SortedSet[T]) RemoveWhere(f func(T) bool) int {
+	var removed []T
+	for v := range s.Values() {
+		if f(v) {
+			removed = append(removed, v)
+		}
+	}
+	for _, v := range removed {
+		s.Remove(v)
+	}
+	return len(removed)
+}
+
+// The following methods are specific to the SortedSet type.
+
+// Backward returns an iterator over the values of the set in descending order.
+func (s *SortedSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range s.elements.Backward() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Ceiling returns the smallest value greater than or equal to v,
+// and reports whether such a value exists.
+//
+// example usage:
+//
+//	NewSortedSet([]int{10,20,30}).Ceiling(15)
+//
+// output:
+//
+//	20, true
+func (s *SortedSet[T]) Ceiling(v T) (T, bool) {
+	c, _, ok := s.elements.Ceiling(v)
+	return c, ok
+}
+
+// Clone returns a copy of the set.
+func (s *SortedSet[T]) Clone() *SortedSet[T] {
+	return s.Filter(func(T) bool { return true })
+}
+
+// Contains returns true if the set contains the value.
+func (s *SortedSet[T]) Contains(v T) bool {
+	return s.elements.Contains(v)
+}
+
+// Filter returns a new sorted set holding the values that satisfy the predicate.
+func (s *SortedSet[T]) Filter(f func(T) bool) *SortedSet[T] {
+	r := NewSortedSet[T]()
+	for v := range s.Values() {
+		if f(v) {
+			r.Add(v)
+		}
+	}
+	return r
+}
+
+// Floor returns the greatest value less than or equal to v,
+// and reports whether such a value exists.
+//
+// example usage:
+//
+//	NewSortedSet([]int{10,20,30}).Floor(15)
+//
+// output:
+//
+//	10, true
+func (s *SortedSet[T]) Floor(v T) (T, bool) {
+	f, _, ok := s.elements.Floor(v)
+	return f, ok
+}
+
+// IsEmpty returns true if the set has no values.
+func (s *SortedSet[T]) IsEmpty() bool {
+	return s.elements.IsEmpty()
+}
+
+// Max returns the greatest value of the set,
+// or an EmptyCollectionError if the set is empty.
+func (s *SortedSet[T]) Max() (T, error) {
+	v, _, err := s.elements.Max()
+	return v, err
+}
+
+// Min returns the smallest value of the set,
+// or an EmptyCollectionError if the set is empty.
+func (s *SortedSet[T]) Min() (T, error) {
+	v, _, err := s.elements.Min()
+	return v, err
+}
+
+// Range returns an iterator over the values in the range [from, to), in ascending order.
+// It only visits the values in range and their ancestors in the tree.
+//
+// example usage:
+//
+//	s := NewSortedSet([]int{1,2,3,4,5})
+//	slices.Collect(s.Range(2, 4))
+//
+// output:
+//
+//	[2 3]
+func (s *SortedSet[T]) Range(from, to T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range s.elements.Range(from, to) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns a slice holding the values of the set in ascending order.
+func (s *SortedSet[T]) ToSlice() []T {
+	return collection.AppendToSlice(s, make([]T, 0, s.Length()))
+}
+
+// implement the Stringer interface
+func (s *SortedSet[T]) String() string {
+	return fmt.Sprintf("SortedSet(%T) %v", *new(T), s.ToSlice())
+}
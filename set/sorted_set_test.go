@@ -0,0 +1,84 @@
+package set
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/charbz/gophers/collection"
+)
+
+func TestSortedSet_ImplementsMutableCollection(t *testing.T) {
+	var _ collection.MutableCollection[int] = NewSortedSet[int]()
+}
+
+func TestSortedSet_Order(t *testing.T) {
+	s := NewSortedSet([]int{5, 3, 9, 1}, []int{3, 7})
+	s.Add(4)
+	if got := slices.Collect(s.Values()); !slices.Equal(got, []int{1, 3, 4, 5, 7, 9}) {
+		t.Errorf("Values() = %v, want %v", got, []int{1, 3, 4, 5, 7, 9})
+	}
+	if got := slices.Collect(s.Backward()); !slices.Equal(got, []int{9, 7, 5, 4, 3, 1}) {
+		t.Errorf("Backward() = %v, want %v", got, []int{9, 7, 5, 4, 3, 1})
+	}
+	if got := s.String(); got != "SortedSet(int) [1 3 4 5 7 9]" {
+		t.Errorf("String() = %v, want %v", got, "SortedSet(int) [1 3 4 5 7 9]")
+	}
+	if !s.Remove(4) || s.Remove(4) || s.Contains(4) {
+		t.Errorf("Remove() did not remove the value")
+	}
+	if n := s.RemoveWhere(func(i int) bool { return i > 5 }); n != 2 || !slices.Equal(s.ToSlice(), []int{1, 3, 5}) {
+		t.Errorf("RemoveWhere() = %v leaving %v, want %v leaving %v", n, s.ToSlice(), 2, []int{1, 3, 5})
+	}
+	if v, err := s.Choice(); err != nil || !s.Contains(v) {
+		t.Errorf("Choice() = %v, %v, want a value of the set", v, err)
+	}
+}
+
+func TestSortedSet_Queries(t *testing.T) {
+	s := NewSortedSet([]string{"kiwi", "apple", "fig", "cherry"})
+	tests := []struct {
+		name   string
+		got    func() (string, bool)
+		want   string
+		wantOk bool
+	}{
+		{name: "floor between", got: func() (string, bool) { return s.Floor("banana") }, want: "apple", wantOk: true},
+		{name: "floor exact", got: func() (string, bool) { return s.Floor("fig") }, want: "fig", wantOk: true},
+		{name: "floor below min", got: func() (string, bool) { return s.Floor("aardvark") }, want: "", wantOk: false},
+		{name: "ceiling between", got: func() (string, bool) { return s.Ceiling("banana") }, want: "cherry", wantOk: true},
+		{name: "ceiling above max", got: func() (string, bool) { return s.Ceiling("lemon") }, want: "", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, ok := tt.got(); got != tt.want || ok != tt.wantOk {
+				t.Errorf("got %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+	if got := slices.Collect(s.Range("b", "g")); !slices.Equal(got, []string{"cherry", "fig"}) {
+		t.Errorf("Range() = %v, want %v", got, []string{"cherry", "fig"})
+	}
+	if lo, err := s.Min(); err != nil || lo != "apple" {
+		t.Errorf("Min() = %v, %v, want %v, %v", lo, err, "apple", nil)
+	}
+	if hi, err := s.Max(); err != nil || hi != "kiwi" {
+		t.Errorf("Max() = %v, %v, want %v, %v", hi, err, "kiwi", nil)
+	}
+	s.Clear()
+	if _, err := s.Min(); err != collection.EmptyCollectionError {
+		t.Errorf("Min() error = %v, want %v", err, collection.EmptyCollectionError)
+	}
+}
+
+func TestSortedSet_CollectionFunctions(t *testing.T) {
+	s := NewSortedSet([]int{4, 1, 3, 2})
+	evens := collection.Filter(s, func(i int) bool { return i%2 == 0 })
+	if got, ok := evens.(*SortedSet[int]); !ok || !slices.Equal(got.ToSlice(), []int{2, 4}) {
+		t.Errorf("Filter() = %v, want %v", evens, []int{2, 4})
+	}
+	clone := s.Clone()
+	clone.Add(0)
+	if s.Contains(0) || clone.Length() != 5 {
+		t.Errorf("Clone() shares storage with the set")
+	}
+}
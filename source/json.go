@@ -0,0 +1,85 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package source
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/charbz/gophers/list"
+)
+
+// DecodeJSONArray decodes a JSON array of values of type T from r into a new List.
+//
+// example usage:
+//
+//	DecodeJSONArray[int](strings.NewReader("[1, 2, 3]"))
+//
+// output:
+//
+//	List(int) [1 2 3], nil
+func DecodeJSONArray[T any](r io.Reader) (*list.List[T], error) {
+	l := list.NewList[T]()
+	for v, err := range DecodeJSONStream[T](r) {
+		if err != nil {
+			return nil, err
+		}
+		l.Add(v)
+	}
+	return l, nil
+}
+
+// DecodeJSONStream returns a lazy iterator over the elements of a JSON array
+// read from r. Elements are decoded one at a time as the iterator is consumed,
+// so arrays larger than memory can be filtered without loading the whole document.
+//
+// If the input is malformed the iterator yields the zero value and the error, then stops.
+//
+// example usage:
+//
+//	for v, err := range DecodeJSONStream[Event](f) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(v)
+//	}
+func DecodeJSONStream[T any](r io.Reader) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		dec := json.NewDecoder(r)
+		if err := expectDelim(dec, '['); err != nil {
+			yield(*new(T), err)
+			return
+		}
+		for dec.More() {
+			var v T
+			if err := dec.Decode(&v); err != nil {
+				yield(*new(T), err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+		if err := expectDelim(dec, ']'); err != nil {
+			yield(*new(T), err)
+		}
+	}
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	t, err := dec.Token()
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return err
+	}
+	if d, ok := t.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("expected %v in JSON input, found %v", delim, t)
+	}
+	return nil
+}
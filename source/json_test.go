@@ -0,0 +1,60 @@
+package source
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+type event struct {
+	ID   int    `json:"id"`
+	Kind string `json:"kind"`
+}
+
+func TestDecodeJSONArray(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []event
+		wantErr bool
+	}{
+		{
+			name:  "array of objects",
+			input: `[{"id": 1, "kind": "a"}, {"id": 2, "kind": "b"}]`,
+			want:  []event{{ID: 1, Kind: "a"}, {ID: 2, Kind: "b"}},
+		},
+		{name: "empty array", input: `[]`, want: []event{}},
+		{name: "not an array", input: `{"id": 1}`, wantErr: true},
+		{name: "truncated array", input: `[{"id": 1}, {"id"`, wantErr: true},
+		{name: "empty input", input: ``, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeJSONArray[event](strings.NewReader(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DecodeJSONArray() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !slices.Equal(got.ToSlice(), tt.want) {
+				t.Errorf("DecodeJSONArray() = %v, want %v", got.ToSlice(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeJSONStream(t *testing.T) {
+	// the input is invalid after the second element, which is never reached.
+	input := strings.NewReader(`[1, 2, 3, 4, oops]`)
+	var got []int
+	for v, err := range DecodeJSONStream[int](input) {
+		if err != nil {
+			t.Fatalf("DecodeJSONStream() error = %v", err)
+		}
+		got = append(got, v)
+		if v == 2 {
+			break
+		}
+	}
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("DecodeJSONStream() = %v, want %v", got, []int{1, 2})
+	}
+}
@@ -0,0 +1,375 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package source
+
+import (
+	"bufio"
+	"container/heap"
+	"context"
+	"encoding/gob"
+	"io"
+	"iter"
+	"os"
+	"slices"
+)
+
+// DefaultSortChunkSize is the number of elements SortExternal
+// sorts in memory at a time when SortOptions.ChunkSize is not set.
+const DefaultSortChunkSize = 1 << 20
+
+// DefaultSortMergeFanIn is the number of temporary files SortExternal
+// merges at a time when SortOptions.MergeFanIn is not set.
+const DefaultSortMergeFanIn = 64
+
+// SortOptions configures SortExternal.
+type SortOptions struct {
+	// ChunkSize is the number of elements sorted in memory before being spilled
+	// to a temporary file, DefaultSortChunkSize if it is not positive.
+	ChunkSize int
+	// MergeFanIn is the maximum number of temporary files open and merged at a time,
+	// DefaultSortMergeFanIn if it is less than 2. When more chunks are spilled, groups
+	// of files are merged into larger files in multiple passes before the final merge.
+	MergeFanIn int
+	// TempDir is the directory of the temporary files, os.TempDir() if it is empty.
+	TempDir string
+	// Progress, if not nil, is called after every chunk spilled to a temporary file,
+	// after every ChunkSize elements merged, and once the sort is complete.
+	Progress func(SortProgress)
+}
+
+// SortProgress reports the progress of SortExternal.
+type SortProgress struct {
+	Read   int  // elements read from the input
+	Runs   int  // sorted chunks spilled to temporary files
+	Merged int  // sorted elements yielded
+	Done   bool // true once every element was yielded
+}
+
+// SortExternal returns an iterator over the elements of seq sorted by less, sorting
+// inputs larger than memory with an external merge sort: the input is read in chunks
+// of opts.ChunkSize elements, which are sorted and spilled to temporary files, and the
+// files are then merged as the iterator is consumed. Inputs that fit in a single chunk
+// are sorted in memory. The sort is stable.
+//
+// Elements are written to the temporary files with encoding/gob, so T must be encodable
+// by gob: only exported struct fields are preserved. The temporary files are removed
+// when the iteration ends, and at most opts.MergeFanIn files are held open at a time.
+//
+// If the context is canceled or a temporary file cannot be written or read,
+// the iterator yields the zero value and the error, then stops.
+//
+// example usage:
+//
+//	f, _ := os.Open("events.json")
+//	defer f.Close()
+//	byTime := func(a, b Event) bool { return a.Time.Before(b.Time) }
+//	for e, err := range SortExternal(ctx, DecodeJSONStream[Event](f), byTime, SortOptions{}) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(e)
+//	}
+func SortExternal[T any](ctx context.Context, seq iter.Seq[T], less func(a, b T) bool, opts SortOptions) iter.Seq2[T, error] {
+	if opts.ChunkSize <= 0 {
+		opts.ChunkSize = DefaultSortChunkSize
+	}
+	if opts.MergeFanIn < 2 {
+		opts.MergeFanIn = DefaultSortMergeFanIn
+	}
+	return func(yield func(T, error) bool) {
+		s := &externalSort[T]{less: less, opts: opts}
+		defer s.close()
+		chunk, err := s.spill(ctx, seq)
+		if err == nil && len(s.runs) == 0 {
+			err = s.emit(ctx, slices.Values(chunk), yield)
+		} else if err == nil {
+			err = s.merge(ctx, yield)
+		}
+		if err != nil {
+			yield(*new(T), err)
+		}
+	}
+}
+
+// externalSort holds the state of a SortExternal iteration.
+type externalSort[T any] struct {
+	less     func(a, b T) bool
+	opts     SortOptions
+	runs     []string   // sorted temporary files
+	open     []*os.File // temporary files being merged
+	progress SortProgress
+	err      error // a read error ending the merge
+}
+
+func (s *externalSort[T]) report() {
+	if s.opts.Progress != nil {
+		s.opts.Progress(s.progress)
+	}
+}
+
+func (s *externalSort[T]) compare(a, b T) int {
+	switch {
+	case s.less(a, b):
+		return -1
+	case s.less(b, a):
+		return 1
+	}
+	return 0
+}
+
+// spill reads the input, spilling every full chunk to a temporary file,
+// and returns the last chunk sorted if no chunk was spilled.
+func (s *externalSort[T]) spill(ctx context.Context, seq iter.Seq[T]) ([]T, error) {
+	var chunk []T
+	var err error
+	for v := range seq {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		chunk = append(chunk, v)
+		s.progress.Read++
+		if len(chunk) == s.opts.ChunkSize {
+			if err = s.writeRun(chunk); err != nil {
+				break
+			}
+			clear(chunk)
+			chunk = chunk[:0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(s.runs) > 0 && len(chunk) > 0 {
+		return nil, s.writeRun(chunk)
+	}
+	slices.SortStableFunc(chunk, s.compare)
+	return chunk, nil
+}
+
+// writeRun sorts a chunk and writes it to a new temporary file.
+func (s *externalSort[T]) writeRun(chunk []T) error {
+	slices.SortStableFunc(chunk, s.compare)
+	name, err := s.write(func(enc *gob.Encoder) error {
+		for i := range chunk {
+			if err := enc.Encode(&chunk[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.runs = append(s.runs, name)
+	s.progress.Runs++
+	s.report()
+	return nil
+}
+
+// write creates a temporary file holding the elements encoded by encode
+// and returns its name. The file is removed if it cannot be written.
+func (s *externalSort[T]) write(encode func(*gob.Encoder) error) (string, error) {
+	f, err := os.CreateTemp(s.opts.TempDir, "gophers-sort-*")
+	if err != nil {
+		return "", err
+	}
+	w := bufio.NewWriter(f)
+	err = encode(gob.NewEncoder(w))
+	if err == nil {
+		err = w.Flush()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// emit yields the sorted elements and reports the progress of the merge.
+func (s *externalSort[T]) emit(ctx context.Context, sorted iter.Seq[T], yield func(T, error) bool) error {
+	for v := range sorted {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !yield(v, nil) {
+			return nil
+		}
+		s.progress.Merged++
+		if s.progress.Merged%s.opts.ChunkSize == 0 {
+			s.report()
+		}
+	}
+	if s.err != nil {
+		return s.err
+	}
+	s.progress.Done = true
+	s.report()
+	return nil
+}
+
+// merge yields the elements of the temporary files in sorted order,
+// first merging groups of files until at most opts.MergeFanIn remain.
+func (s *externalSort[T]) merge(ctx context.Context, yield func(T, error) bool) error {
+	for len(s.runs) > s.opts.MergeFanIn {
+		if err := s.mergePass(ctx); err != nil {
+			return err
+		}
+	}
+	h, err := s.openRuns(s.runs)
+	if err != nil {
+		return err
+	}
+	return s.emit(ctx, s.merged(h), yield)
+}
+
+// mergePass merges consecutive groups of opts.MergeFanIn temporary files into
+// new temporary files, which keeps the merge stable, and removes the merged files.
+func (s *externalSort[T]) mergePass(ctx context.Context) error {
+	var runs []string
+	for i := 0; i < len(s.runs); i += s.opts.MergeFanIn {
+		group := s.runs[i:min(i+s.opts.MergeFanIn, len(s.runs))]
+		if len(group) == 1 {
+			runs = append(runs, group[0])
+			continue
+		}
+		h, err := s.openRuns(group)
+		var name string
+		if err == nil {
+			name, err = s.write(func(enc *gob.Encoder) error {
+				for v := range s.merged(h) {
+					if err := ctx.Err(); err != nil {
+						return err
+					}
+					if err := enc.Encode(&v); err != nil {
+						return err
+					}
+				}
+				return s.err
+			})
+		}
+		s.closeRuns()
+		for _, f := range group {
+			os.Remove(f)
+		}
+		if err != nil {
+			s.runs = append(runs, s.runs[i+len(group):]...)
+			return err
+		}
+		runs = append(runs, name)
+	}
+	s.runs = runs
+	return nil
+}
+
+// openRuns opens the temporary files and returns a heap of their first elements.
+func (s *externalSort[T]) openRuns(names []string) (*runHeap[T], error) {
+	h := &runHeap[T]{compare: s.compare}
+	for i, name := range names {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		s.open = append(s.open, f)
+		r := &run[T]{index: i, dec: gob.NewDecoder(bufio.NewReader(f))}
+		ok, err := r.next()
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			h.runs = append(h.runs, r)
+		}
+	}
+	heap.Init(h)
+	return h, nil
+}
+
+// merged returns an iterator over the elements of the runs of the heap in sorted order.
+// A read error stops the iteration and is stored in s.err.
+func (s *externalSort[T]) merged(h *runHeap[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for h.Len() > 0 {
+			r := h.runs[0]
+			if !yield(r.head) {
+				return
+			}
+			ok, err := r.next()
+			if err != nil {
+				s.err = err
+				return
+			}
+			if ok {
+				heap.Fix(h, 0)
+			} else {
+				heap.Pop(h)
+			}
+		}
+	}
+}
+
+// closeRuns closes the temporary files being merged.
+func (s *externalSort[T]) closeRuns() {
+	for _, f := range s.open {
+		f.Close()
+	}
+	s.open = nil
+}
+
+// close closes and removes the temporary files.
+func (s *externalSort[T]) close() {
+	s.closeRuns()
+	for _, name := range s.runs {
+		os.Remove(name)
+	}
+}
+
+// run is a sorted temporary file being merged.
+type run[T any] struct {
+	index int
+	dec   *gob.Decoder
+	head  T
+}
+
+// next decodes the next element of the run and reports whether there was one.
+func (r *run[T]) next() (bool, error) {
+	// decode into a zero value, since gob does not transmit zero fields.
+	var v T
+	if err := r.dec.Decode(&v); err == io.EOF {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	r.head = v
+	return true, nil
+}
+
+// runHeap orders runs by their next element, then by their index,
+// which keeps the merge stable.
+type runHeap[T any] struct {
+	runs    []*run[T]
+	compare func(a, b T) int
+}
+
+func (h *runHeap[T]) Len() int { return len(h.runs) }
+
+func (h *runHeap[T]) Less(i, j int) bool {
+	if c := h.compare(h.runs[i].head, h.runs[j].head); c != 0 {
+		return c < 0
+	}
+	return h.runs[i].index < h.runs[j].index
+}
+
+func (h *runHeap[T]) Swap(i, j int) { h.runs[i], h.runs[j] = h.runs[j], h.runs[i] }
+
+func (h *runHeap[T]) Push(x any) { h.runs = append(h.runs, x.(*run[T])) }
+
+func (h *runHeap[T]) Pop() any {
+	old := h.runs
+	r := old[len(old)-1]
+	h.runs = old[:len(old)-1]
+	return r
+}
@@ -0,0 +1,155 @@
+package source
+
+import (
+	"cmp"
+	"context"
+	"math/rand"
+	"os"
+	"slices"
+	"testing"
+)
+
+func TestSortExternal(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	input := make([]int, 1050)
+	for i := range input {
+		input[i] = r.Intn(500)
+	}
+	want := slices.Sorted(slices.Values(input))
+	less := func(a, b int) bool { return a < b }
+	tests := []struct {
+		name      string
+		chunkSize int
+		wantRuns  int
+	}{
+		{name: "spilled chunks", chunkSize: 100, wantRuns: 11},
+		{name: "exact chunks", chunkSize: 525, wantRuns: 2},
+		{name: "in memory", chunkSize: 2000, wantRuns: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			var last SortProgress
+			opts := SortOptions{ChunkSize: tt.chunkSize, TempDir: dir, Progress: func(p SortProgress) { last = p }}
+			var got []int
+			for v, err := range SortExternal(context.Background(), slices.Values(input), less, opts) {
+				if err != nil {
+					t.Fatalf("SortExternal() error = %v", err)
+				}
+				got = append(got, v)
+			}
+			if !slices.Equal(got, want) {
+				t.Errorf("SortExternal() is not sorted")
+			}
+			wantProgress := SortProgress{Read: len(input), Runs: tt.wantRuns, Merged: len(input), Done: true}
+			if last != wantProgress {
+				t.Errorf("Progress() = %+v, want %+v", last, wantProgress)
+			}
+			if files, _ := os.ReadDir(dir); len(files) != 0 {
+				t.Errorf("SortExternal() left %d temporary files", len(files))
+			}
+		})
+	}
+}
+
+func TestSortExternal_Stable(t *testing.T) {
+	type row struct {
+		Key   int
+		Order int
+	}
+	var input []row
+	for i := range 300 {
+		input = append(input, row{Key: i % 3, Order: i})
+	}
+	byKey := func(a, b row) bool { return a.Key < b.Key }
+	var got []row
+	for v, err := range SortExternal(context.Background(), slices.Values(input), byKey, SortOptions{ChunkSize: 7, TempDir: t.TempDir()}) {
+		if err != nil {
+			t.Fatalf("SortExternal() error = %v", err)
+		}
+		got = append(got, v)
+	}
+	want := slices.Clone(input)
+	slices.SortStableFunc(want, func(a, b row) int { return cmp.Compare(a.Key, b.Key) })
+	if !slices.Equal(got, want) {
+		t.Errorf("SortExternal() did not keep equal elements in input order")
+	}
+}
+
+func TestSortExternal_ManyChunks(t *testing.T) {
+	type row struct {
+		Key   int
+		Order int
+	}
+	var input []row
+	for i := range 1000 {
+		input = append(input, row{Key: (i * 7) % 10, Order: i})
+	}
+	byKey := func(a, b row) bool { return a.Key < b.Key }
+	dir := t.TempDir()
+	opts := SortOptions{ChunkSize: 3, MergeFanIn: 4, TempDir: dir}
+	var got []row
+	for v, err := range SortExternal(context.Background(), slices.Values(input), byKey, opts) {
+		if err != nil {
+			t.Fatalf("SortExternal() error = %v", err)
+		}
+		if len(got) == 0 {
+			// the 334 chunks are merged in passes until at most MergeFanIn files remain.
+			if files, _ := os.ReadDir(dir); len(files) > opts.MergeFanIn {
+				t.Errorf("SortExternal() merged %d temporary files at once, want at most %d", len(files), opts.MergeFanIn)
+			}
+		}
+		got = append(got, v)
+	}
+	want := slices.Clone(input)
+	slices.SortStableFunc(want, func(a, b row) int { return cmp.Compare(a.Key, b.Key) })
+	if !slices.Equal(got, want) {
+		t.Errorf("SortExternal() is not a stable sort of the input")
+	}
+	if files, _ := os.ReadDir(dir); len(files) != 0 {
+		t.Errorf("SortExternal() left %d temporary files", len(files))
+	}
+}
+
+func TestSortExternal_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dir := t.TempDir()
+	opts := SortOptions{ChunkSize: 10, TempDir: dir, Progress: func(p SortProgress) {
+		if p.Runs == 2 {
+			cancel()
+		}
+	}}
+	input := slices.Values(make([]int, 100))
+	n := 0
+	for _, err := range SortExternal(ctx, input, func(a, b int) bool { return a < b }, opts) {
+		if err != context.Canceled {
+			t.Fatalf("SortExternal() error = %v, want %v", err, context.Canceled)
+		}
+		n++
+	}
+	if n != 1 {
+		t.Errorf("SortExternal() yielded %d times after cancellation, want %d", n, 1)
+	}
+	if files, _ := os.ReadDir(dir); len(files) != 0 {
+		t.Errorf("SortExternal() left %d temporary files", len(files))
+	}
+}
+
+func TestSortExternal_StopsEarly(t *testing.T) {
+	dir := t.TempDir()
+	input := slices.Values([]string{"d", "b", "e", "a", "c"})
+	var got []string
+	for v := range SortExternal(context.Background(), input, func(a, b string) bool { return a < b }, SortOptions{ChunkSize: 2, TempDir: dir}) {
+		got = append(got, v)
+		if len(got) == 2 {
+			break
+		}
+	}
+	if !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("SortExternal() = %v, want %v", got, []string{"a", "b"})
+	}
+	if files, _ := os.ReadDir(dir); len(files) != 0 {
+		t.Errorf("SortExternal() left %d temporary files", len(files))
+	}
+}
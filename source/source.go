@@ -0,0 +1,87 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package source implements helpers that produce collections and iterators
+// from external sources of data such as files, readers and strings, so that
+// processing pipelines can start from a file without bufio boilerplate.
+package source
+
+import (
+	"bufio"
+	"io"
+	"iter"
+	"os"
+
+	"github.com/charbz/gophers/sequence"
+)
+
+// MaxLineSize is the maximum length of a single line read by the functions
+// of this package. Longer lines cause the read to fail with bufio.ErrTooLong.
+const MaxLineSize = 1024 * 1024
+
+// ScannerSeq returns a lazy iterator over the lines of r, without the
+// trailing end-of-line markers. Lines are read on demand as the iterator
+// is consumed and reading stops as soon as the consumer stops iterating.
+//
+// A read error ends the iteration silently, use LinesSequence
+// when read errors need to be reported.
+//
+// example usage:
+//
+//	f, _ := os.Open("access.log")
+//	defer f.Close()
+//	for line := range ScannerSeq(f) {
+//		fmt.Println(line)
+//	}
+func ScannerSeq(r io.Reader) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		scanner := newScanner(r)
+		for scanner.Scan() {
+			if !yield(scanner.Text()) {
+				return
+			}
+		}
+	}
+}
+
+// LinesSequence reads all lines of r into a new Sequence.
+//
+// example usage:
+//
+//	LinesSequence(strings.NewReader("a\nb\nc"))
+//
+// output:
+//
+//	Seq(string) [a b c], nil
+func LinesSequence(r io.Reader) (*sequence.Sequence[string], error) {
+	lines := sequence.NewSequence[string]()
+	scanner := newScanner(r)
+	for scanner.Scan() {
+		lines.Add(scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// ReadLines reads all lines of the file at path into a new Sequence.
+//
+// example usage:
+//
+//	lines, err := ReadLines("access.log")
+func ReadLines(path string) (*sequence.Sequence[string], error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LinesSequence(f)
+}
+
+func newScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, MaxLineSize)
+	return scanner
+}
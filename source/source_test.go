@@ -0,0 +1,79 @@
+package source
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestScannerSeq(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{name: "lines", input: "a\nb\nc", want: []string{"a", "b", "c"}},
+		{name: "trailing newline", input: "a\r\nb\n", want: []string{"a", "b"}},
+		{name: "empty lines", input: "a\n\nb", want: []string{"a", "", "b"}},
+		{name: "empty input", input: "", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Collect(ScannerSeq(strings.NewReader(tt.input)))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ScannerSeq() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScannerSeqStopsEarly(t *testing.T) {
+	r := iotest.OneByteReader(strings.NewReader("a\nb\nc\n"))
+	for line := range ScannerSeq(r) {
+		if line != "a" {
+			t.Errorf("ScannerSeq() = %q, want %q", line, "a")
+		}
+		break
+	}
+	rest := slices.Collect(ScannerSeq(r))
+	if !slices.Equal(rest, []string{"b", "c"}) {
+		t.Errorf("ScannerSeq() read ahead, remaining lines = %q, want %q", rest, []string{"b", "c"})
+	}
+}
+
+func TestLinesSequence(t *testing.T) {
+	lines, err := LinesSequence(strings.NewReader("a\nb"))
+	if err != nil {
+		t.Fatalf("LinesSequence() error = %v", err)
+	}
+	if !slices.Equal(lines.ToSlice(), []string{"a", "b"}) {
+		t.Errorf("LinesSequence() = %v, want %v", lines.ToSlice(), []string{"a", "b"})
+	}
+
+	readErr := errors.New("read error")
+	if _, err := LinesSequence(iotest.ErrReader(readErr)); !errors.Is(err, readErr) {
+		t.Errorf("LinesSequence() error = %v, want %v", err, readErr)
+	}
+}
+
+func TestReadLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lines.txt")
+	if err := os.WriteFile(path, []byte("one\ntwo\nthree\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	lines, err := ReadLines(path)
+	if err != nil {
+		t.Fatalf("ReadLines() error = %v", err)
+	}
+	if !slices.Equal(lines.ToSlice(), []string{"one", "two", "three"}) {
+		t.Errorf("ReadLines() = %v, want %v", lines.ToSlice(), []string{"one", "two", "three"})
+	}
+
+	if _, err := ReadLines(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Errorf("ReadLines() of a missing file returned no error")
+	}
+}
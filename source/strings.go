@@ -0,0 +1,152 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+package source
+
+import (
+	"iter"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/charbz/gophers/sequence"
+)
+
+// FieldsSeq returns a lazy iterator over the fields of s, which are the runs of
+// characters between white space as defined by unicode.IsSpace, like strings.Fields.
+//
+// example usage:
+//
+//	for word := range FieldsSeq("  the quick\tfox\n") {
+//		fmt.Println(word)
+//	}
+//
+// output:
+//
+//	the
+//	quick
+//	fox
+func FieldsSeq(s string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		start := -1
+		for i := 0; i < len(s); {
+			r, size := utf8.DecodeRuneInString(s[i:])
+			if unicode.IsSpace(r) {
+				if start >= 0 && !yield(s[start:i]) {
+					return
+				}
+				start = -1
+			} else if start < 0 {
+				start = i
+			}
+			i += size
+		}
+		if start >= 0 {
+			yield(s[start:])
+		}
+	}
+}
+
+// SplitSeq returns a lazy iterator over the substrings of s separated by sep,
+// like strings.Split. If sep is empty, it splits s after each UTF-8 sequence.
+//
+// example usage:
+//
+//	for field := range SplitSeq("a,b,,c", ",") {
+//		fmt.Printf("%q ", field)
+//	}
+//
+// output:
+//
+//	"a" "b" "" "c"
+func SplitSeq(s, sep string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		if sep == "" {
+			for i := 0; i < len(s); {
+				_, size := utf8.DecodeRuneInString(s[i:])
+				if !yield(s[i : i+size]) {
+					return
+				}
+				i += size
+			}
+			return
+		}
+		for {
+			i := strings.Index(s, sep)
+			if i < 0 {
+				break
+			}
+			if !yield(s[:i]) {
+				return
+			}
+			s = s[i+len(sep):]
+		}
+		yield(s)
+	}
+}
+
+// SplitRegexpSeq returns an iterator over the substrings of s separated by
+// the matches of re, like re.Split(s, -1).
+//
+// example usage:
+//
+//	for field := range SplitRegexpSeq("a1b22c", regexp.MustCompile(`\d+`)) {
+//		fmt.Println(field)
+//	}
+//
+// output:
+//
+//	a
+//	b
+//	c
+func SplitRegexpSeq(s string, re *regexp.Regexp) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for _, field := range re.Split(s, -1) {
+			if !yield(field) {
+				return
+			}
+		}
+	}
+}
+
+// FieldsSequence returns a new Sequence holding the fields of s, see FieldsSeq.
+//
+// example usage:
+//
+//	FieldsSequence("the quick  fox")
+//
+// output:
+//
+//	Seq(string) [the quick fox]
+func FieldsSequence(s string) *sequence.Sequence[string] {
+	return sequence.FromSeq(FieldsSeq(s))
+}
+
+// SplitSequence returns a new Sequence holding the substrings of s separated by sep, see SplitSeq.
+//
+// example usage:
+//
+//	SplitSequence("a,b,c", ",")
+//
+// output:
+//
+//	Seq(string) [a b c]
+func SplitSequence(s, sep string) *sequence.Sequence[string] {
+	return sequence.FromSeq(SplitSeq(s, sep))
+}
+
+// SplitRegexpSequence returns a new Sequence holding the substrings of s
+// separated by the matches of re, see SplitRegexpSeq.
+//
+// example usage:
+//
+//	SplitRegexpSequence("a, b;c", regexp.MustCompile(`[,;]\s*`))
+//
+// output:
+//
+//	Seq(string) [a b c]
+func SplitRegexpSequence(s string, re *regexp.Regexp) *sequence.Sequence[string] {
+	return sequence.NewSequence(re.Split(s, -1))
+}
@@ -0,0 +1,86 @@
+package source
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFieldsSeq(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "words", input: "the quick fox"},
+		{name: "surrounding space", input: "  the\tquick\n\nfox \r\n"},
+		{name: "unicode space", input: "über straße fin"},
+		{name: "only space", input: " \t\n"},
+		{name: "empty", input: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Collect(FieldsSeq(tt.input))
+			if want := strings.Fields(tt.input); !slices.Equal(got, want) {
+				t.Errorf("FieldsSeq() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestSplitSeq(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		sep   string
+	}{
+		{name: "delimiter", input: "a,b,c", sep: ","},
+		{name: "empty fields", input: ",a,,b,", sep: ","},
+		{name: "multi-byte delimiter", input: "a::b::c", sep: "::"},
+		{name: "no delimiter", input: "abc", sep: ","},
+		{name: "empty separator", input: "héllo", sep: ""},
+		{name: "empty input", input: "", sep: ","},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Collect(SplitSeq(tt.input, tt.sep))
+			if want := strings.Split(tt.input, tt.sep); !slices.Equal(got, want) {
+				t.Errorf("SplitSeq() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestSplitSeqStopsEarly(t *testing.T) {
+	var got []string
+	for field := range SplitSeq("a,b,c", ",") {
+		got = append(got, field)
+		if field == "b" {
+			break
+		}
+	}
+	if !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("SplitSeq() = %q, want %q", got, []string{"a", "b"})
+	}
+}
+
+func TestSplitRegexpSeq(t *testing.T) {
+	re := regexp.MustCompile(`[,;]\s*`)
+	got := slices.Collect(SplitRegexpSeq("a, b;c,", re))
+	if want := []string{"a", "b", "c", ""}; !slices.Equal(got, want) {
+		t.Errorf("SplitRegexpSeq() = %q, want %q", got, want)
+	}
+}
+
+func TestTokenSequences(t *testing.T) {
+	if got := FieldsSequence(" to be  or not "); !slices.Equal(got.ToSlice(), []string{"to", "be", "or", "not"}) {
+		t.Errorf("FieldsSequence() = %v, want %v", got, []string{"to", "be", "or", "not"})
+	}
+	if got := SplitSequence("1|2|3", "|"); !slices.Equal(got.ToSlice(), []string{"1", "2", "3"}) {
+		t.Errorf("SplitSequence() = %v, want %v", got, []string{"1", "2", "3"})
+	}
+	got := SplitRegexpSequence("a1b22c", regexp.MustCompile(`\d+`))
+	if !slices.Equal(got.ToSlice(), []string{"a", "b", "c"}) {
+		t.Errorf("SplitRegexpSequence() = %v, want %v", got, []string{"a", "b", "c"})
+	}
+}
@@ -0,0 +1,403 @@
+// Copyright (c) 2024 Gophers. All rights reserved.
+// Use of this source code is governed by the MIT
+// license that can be found in the LICENSE file.
+
+// Package treemap implements support for a generic sorted Map.
+// A Map associates unique keys with values and keeps its entries
+// sorted by key in a balanced binary search tree (an AVL tree), so that
+// lookups, insertions and removals run in O(log n) time and entries can be
+// iterated in key order, or within a range of keys.
+//
+// The values of a Map can be iterated with Values, which makes them usable
+// with the iterator functions of the collection package.
+package treemap
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/charbz/gophers/collection"
+)
+
+type node[K cmp.Ordered, V any] struct {
+	key    K
+	value  V
+	left   *node[K, V]
+	right  *node[K, V]
+	height int
+}
+
+// Map is a map whose entries are sorted by key.
+type Map[K cmp.Ordered, V any] struct {
+	root *node[K, V]
+	size int
+}
+
+// New is a constructor for an empty Map.
+func New[K cmp.Ordered, V any]() *Map[K, V] {
+	return new(Map[K, V])
+}
+
+// FromMap is a constructor for a Map holding the entries of a Go map.
+func FromMap[K cmp.Ordered, V any](m map[K]V) *Map[K, V] {
+	t := New[K, V]()
+	for k, v := range m {
+		t.Put(k, v)
+	}
+	return t
+}
+
+// Length returns the number of entries in the map.
+func (t *Map[K, V]) Length() int {
+	return t.size
+}
+
+// IsEmpty returns true if the map has no entries.
+func (t *Map[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Put associates the value with the key, replacing any previous value,
+// and reports whether the key was already present.
+func (t *Map[K, V]) Put(k K, v V) bool {
+	var replaced bool
+	t.root = t.put(t.root, k, v, &replaced)
+	if !replaced {
+		t.size++
+	}
+	return replaced
+}
+
+func (t *Map[K, V]) put(n *node[K, V], k K, v V, replaced *bool) *node[K, V] {
+	if n == nil {
+		return &node[K, V]{key: k, value: v, height: 1}
+	}
+	switch c := cmp.Compare(k, n.key); {
+	case c < 0:
+		n.left = t.put(n.left, k, v, replaced)
+	case c > 0:
+		n.right = t.put(n.right, k, v, replaced)
+	default:
+		n.value = v
+		*replaced = true
+		return n
+	}
+	return rebalance(n)
+}
+
+// Get returns the value associated with the key,
+// and reports whether the key is present.
+func (t *Map[K, V]) Get(k K) (V, bool) {
+	n := t.root
+	for n != nil {
+		switch c := cmp.Compare(k, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+	return *new(V), false
+}
+
+// Contains returns true if the key is present in the map.
+func (t *Map[K, V]) Contains(k K) bool {
+	_, ok := t.Get(k)
+	return ok
+}
+
+// Remove removes the key and its value from the map,
+// and reports whether the key was present.
+func (t *Map[K, V]) Remove(k K) bool {
+	var removed bool
+	t.root = t.remove(t.root, k, &removed)
+	if removed {
+		t.size--
+	}
+	return removed
+}
+
+func (t *Map[K, V]) remove(n *node[K, V], k K, removed *bool) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+	switch c := cmp.Compare(k, n.key); {
+	case c < 0:
+		n.left = t.remove(n.left, k, removed)
+	case c > 0:
+		n.right = t.remove(n.right, k, removed)
+	default:
+		*removed = true
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		// replace the node by its successor, the minimum of its right subtree.
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		n.key, n.value = successor.key, successor.value
+		n.right = t.remove(n.right, successor.key, new(bool))
+	}
+	return rebalance(n)
+}
+
+// Clear removes all entries from the map.
+func (t *Map[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Min returns the entry with the smallest key,
+// or an EmptyCollectionError if the map is empty.
+func (t *Map[K, V]) Min() (K, V, error) {
+	if t.root == nil {
+		return *new(K), *new(V), collection.EmptyCollectionError
+	}
+	n := t.root
+	for n.left != nil {
+		n = n.left
+	}
+	return n.key, n.value, nil
+}
+
+// Max returns the entry with the greatest key,
+// or an EmptyCollectionError if the map is empty.
+func (t *Map[K, V]) Max() (K, V, error) {
+	if t.root == nil {
+		return *new(K), *new(V), collection.EmptyCollectionError
+	}
+	n := t.root
+	for n.right != nil {
+		n = n.right
+	}
+	return n.key, n.value, nil
+}
+
+// Floor returns the entry with the greatest key less than or equal to k,
+// and reports whether such an entry exists.
+//
+// example usage:
+//
+//	t := FromMap(map[int]string{10: "a", 20: "b"})
+//	t.Floor(15)
+//
+// output:
+//
+//	10, a, true
+func (t *Map[K, V]) Floor(k K) (K, V, bool) {
+	var found *node[K, V]
+	for n := t.root; n != nil; {
+		switch c := cmp.Compare(k, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			found = n
+			n = n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+	if found == nil {
+		return *new(K), *new(V), false
+	}
+	return found.key, found.value, true
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to k,
+// and reports whether such an entry exists.
+//
+// example usage:
+//
+//	t := FromMap(map[int]string{10: "a", 20: "b"})
+//	t.Ceiling(15)
+//
+// output:
+//
+//	20, b, true
+func (t *Map[K, V]) Ceiling(k K) (K, V, bool) {
+	var found *node[K, V]
+	for n := t.root; n != nil; {
+		switch c := cmp.Compare(k, n.key); {
+		case c < 0:
+			found = n
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+	if found == nil {
+		return *new(K), *new(V), false
+	}
+	return found.key, found.value, true
+}
+
+// All returns an iterator over the entries of the map in ascending key order.
+func (t *Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		ascend(t.root, nil, nil, yield)
+	}
+}
+
+// Backward returns an iterator over the entries of the map in descending key order.
+func (t *Map[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		descend(t.root, yield)
+	}
+}
+
+// Range returns an iterator over the entries whose key is in the range [lo, hi),
+// in ascending key order. It only visits the entries in range and their ancestors.
+//
+// example usage:
+//
+//	t := FromMap(map[int]string{1: "a", 2: "b", 3: "c", 4: "d"})
+//	for k, v := range t.Range(2, 4) {
+//	  fmt.Println(k, v)
+//	}
+//
+// output:
+//
+//	2 b
+//	3 c
+func (t *Map[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		ascend(t.root, &lo, &hi, yield)
+	}
+}
+
+// Keys returns an iterator over the keys of the map in ascending order.
+func (t *Map[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range t.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values of the map in ascending key order.
+func (t *Map[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range t.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ToMap returns a new Go map holding the entries of the map.
+func (t *Map[K, V]) ToMap() map[K]V {
+	m := make(map[K]V, t.size)
+	for k, v := range t.All() {
+		m[k] = v
+	}
+	return m
+}
+
+// implement the Stringer interface
+func (t *Map[K, V]) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "TreeMap(%T, %T) [", *new(K), *new(V))
+	i := 0
+	for k, v := range t.All() {
+		if i > 0 {
+			b.WriteString(" ")
+		}
+		fmt.Fprintf(&b, "%v:%v", k, v)
+		i++
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
+// ascend yields the entries of the subtree in ascending order,
+// restricted to the keys in [lo, hi) when the bounds are not nil.
+func ascend[K cmp.Ordered, V any](n *node[K, V], lo, hi *K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	aboveLo := lo == nil || cmp.Compare(n.key, *lo) >= 0
+	belowHi := hi == nil || cmp.Compare(n.key, *hi) < 0
+	if aboveLo && !ascend(n.left, lo, hi, yield) {
+		return false
+	}
+	if aboveLo && belowHi && !yield(n.key, n.value) {
+		return false
+	}
+	if belowHi {
+		return ascend(n.right, lo, hi, yield)
+	}
+	return true
+}
+
+// descend yields the entries of the subtree in descending order.
+func descend[K cmp.Ordered, V any](n *node[K, V], yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return descend(n.right, yield) && yield(n.key, n.value) && descend(n.left, yield)
+}
+
+func height[K cmp.Ordered, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *node[K, V]) update() {
+	n.height = 1 + max(height(n.left), height(n.right))
+}
+
+func (n *node[K, V]) balance() int {
+	return height(n.left) - height(n.right)
+}
+
+func rotateRight[K cmp.Ordered, V any](n *node[K, V]) *node[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.update()
+	l.update()
+	return l
+}
+
+func rotateLeft[K cmp.Ordered, V any](n *node[K, V]) *node[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.update()
+	r.update()
+	return r
+}
+
+// rebalance restores the AVL invariant of a node whose subtrees
+// differ in height by at most 2, and returns the new subtree root.
+func rebalance[K cmp.Ordered, V any](n *node[K, V]) *node[K, V] {
+	n.update()
+	switch b := n.balance(); {
+	case b > 1:
+		if n.left.balance() < 0 {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case b < -1:
+		if n.right.balance() > 0 {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+	return n
+}
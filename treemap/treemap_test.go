@@ -0,0 +1,174 @@
+package treemap
+
+import (
+	"maps"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/charbz/gophers/collection"
+)
+
+// assertInvariants checks that the tree is ordered and balanced, and that heights are correct.
+func assertInvariants[V any](t *testing.T, m *Map[int, V]) {
+	t.Helper()
+	var check func(n *node[int, V], lo, hi *int) int
+	check = func(n *node[int, V], lo, hi *int) int {
+		if n == nil {
+			return 0
+		}
+		if (lo != nil && n.key <= *lo) || (hi != nil && n.key >= *hi) {
+			t.Fatalf("key %v is out of order", n.key)
+		}
+		l, r := check(n.left, lo, &n.key), check(n.right, &n.key, hi)
+		if l-r > 1 || r-l > 1 || n.height != 1+max(l, r) {
+			t.Fatalf("node %v is unbalanced: left %v, right %v, height %v", n.key, l, r, n.height)
+		}
+		return n.height
+	}
+	check(m.root, nil, nil)
+}
+
+func TestMap_RandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	m := New[int, int]()
+	want := make(map[int]int)
+	for i := range 5000 {
+		k := r.Intn(500)
+		if r.Intn(3) == 0 {
+			_, ok := want[k]
+			if got := m.Remove(k); got != ok {
+				t.Fatalf("Remove(%v) = %v, want %v", k, got, ok)
+			}
+			delete(want, k)
+		} else {
+			_, ok := want[k]
+			if got := m.Put(k, i); got != ok {
+				t.Fatalf("Put(%v) = %v, want %v", k, got, ok)
+			}
+			want[k] = i
+		}
+	}
+	assertInvariants(t, m)
+	if m.Length() != len(want) {
+		t.Errorf("Length() = %v, want %v", m.Length(), len(want))
+	}
+	if !maps.Equal(m.ToMap(), want) {
+		t.Errorf("ToMap() = %v, want %v", m.ToMap(), want)
+	}
+	keys := slices.Collect(m.Keys())
+	if !slices.Equal(keys, slices.Sorted(maps.Keys(want))) {
+		t.Errorf("Keys() = %v, want sorted keys", keys)
+	}
+	for k, v := range want {
+		if got, ok := m.Get(k); got != v || !ok {
+			t.Errorf("Get(%v) = %v, %v, want %v, %v", k, got, ok, v, true)
+		}
+	}
+}
+
+func TestMap_FloorCeiling(t *testing.T) {
+	m := FromMap(map[int]string{10: "a", 20: "b", 30: "c"})
+	tests := []struct {
+		k           int
+		floor, ceil int
+		floorOk     bool
+		ceilOk      bool
+	}{
+		{k: 5, ceil: 10, ceilOk: true},
+		{k: 10, floor: 10, floorOk: true, ceil: 10, ceilOk: true},
+		{k: 15, floor: 10, floorOk: true, ceil: 20, ceilOk: true},
+		{k: 30, floor: 30, floorOk: true, ceil: 30, ceilOk: true},
+		{k: 35, floor: 30, floorOk: true},
+	}
+	for _, tt := range tests {
+		if k, _, ok := m.Floor(tt.k); k != tt.floor || ok != tt.floorOk {
+			t.Errorf("Floor(%v) = %v, %v, want %v, %v", tt.k, k, ok, tt.floor, tt.floorOk)
+		}
+		if k, _, ok := m.Ceiling(tt.k); k != tt.ceil || ok != tt.ceilOk {
+			t.Errorf("Ceiling(%v) = %v, %v, want %v, %v", tt.k, k, ok, tt.ceil, tt.ceilOk)
+		}
+	}
+}
+
+func TestMap_RangeNaN(t *testing.T) {
+	m := New[float64, string]()
+	for _, k := range []float64{2, math.NaN(), -1, 0} {
+		m.Put(k, "v")
+	}
+	// NaN keys are ordered before every other key, as with cmp.Compare.
+	var keys []float64
+	for k := range m.Range(math.NaN(), 1) {
+		keys = append(keys, k)
+	}
+	if len(keys) != 3 || !math.IsNaN(keys[0]) || keys[1] != -1 || keys[2] != 0 {
+		t.Errorf("Range() = %v, want %v", keys, []float64{math.NaN(), -1, 0})
+	}
+	keys = keys[:0]
+	for k := range m.Range(-1, 3) {
+		keys = append(keys, k)
+	}
+	if !slices.Equal(keys, []float64{-1, 0, 2}) {
+		t.Errorf("Range() = %v, want %v", keys, []float64{-1, 0, 2})
+	}
+}
+
+func TestMap_Range(t *testing.T) {
+	m := New[int, int]()
+	for i := range 20 {
+		m.Put(i, i*i)
+	}
+	var keys []int
+	for k, v := range m.Range(5, 9) {
+		if v != k*k {
+			t.Errorf("Range() yielded %v:%v, want %v:%v", k, v, k, k*k)
+		}
+		keys = append(keys, k)
+	}
+	if !slices.Equal(keys, []int{5, 6, 7, 8}) {
+		t.Errorf("Range() = %v, want %v", keys, []int{5, 6, 7, 8})
+	}
+	for range m.Range(0, 20) {
+		break
+	}
+	if got := slices.Collect(m.Values()); len(got) != 20 || got[3] != 9 {
+		t.Errorf("Values() = %v, want squares", got)
+	}
+	var backward []int
+	for k := range m.Backward() {
+		if len(backward) == 3 {
+			break
+		}
+		backward = append(backward, k)
+	}
+	if !slices.Equal(backward, []int{19, 18, 17}) {
+		t.Errorf("Backward() = %v, want %v", backward, []int{19, 18, 17})
+	}
+}
+
+func TestMap_MinMax(t *testing.T) {
+	m := New[string, int]()
+	if _, _, err := m.Min(); err != collection.EmptyCollectionError {
+		t.Errorf("Min() error = %v, want %v", err, collection.EmptyCollectionError)
+	}
+	if _, _, err := m.Max(); err != collection.EmptyCollectionError {
+		t.Errorf("Max() error = %v, want %v", err, collection.EmptyCollectionError)
+	}
+	m.Put("b", 2)
+	m.Put("a", 1)
+	m.Put("c", 3)
+	if k, v, err := m.Min(); k != "a" || v != 1 || err != nil {
+		t.Errorf("Min() = %v, %v, %v, want %v, %v, %v", k, v, err, "a", 1, nil)
+	}
+	if k, v, err := m.Max(); k != "c" || v != 3 || err != nil {
+		t.Errorf("Max() = %v, %v, %v, want %v, %v, %v", k, v, err, "c", 3, nil)
+	}
+	if got := m.String(); got != "TreeMap(string, int) [a:1 b:2 c:3]" {
+		t.Errorf("String() = %v, want %v", got, "TreeMap(string, int) [a:1 b:2 c:3]")
+	}
+	m.Clear()
+	if !m.IsEmpty() || m.Contains("a") {
+		t.Errorf("Clear() = %v, want empty", m)
+	}
+}